@@ -9,28 +9,78 @@ const (
 	eventWrPointsErr     = "write points failure"
 	eventBathcPtrErr     = "new bathc points failure"
 	eventClientErr       = "http client creation failure"
-	eventNewClientErr    = "new client and points err"
 	metricPhyIf          = "phy_interface"
 	ifsLogTopic          = "interface_stats"
 	eventParseFromPrxErr = "parse name from prefix val err"
+	eventNoPrefixErr     = "keys before any prefix, dropping them"
 	eventBathcPointrErr  = "new bathc point failure"
-	eventParseQueuKeyErr = "strconv atoi err"
 	eventCloseSendErr    = "close send err"
 	eventRecvErr         = "grpc open config telemetry recv err"
 	eventSyncRespRecv    = "recved sync resp"
+	eventUnsupported     = "path is not decoded, dropping its data"
 	cfgErrTopic          = "config"
 	cfgReadErrEv         = "read config failure"
 	grpcTopic            = "grpc"
+	grpcResolveErrEv     = "resolve host failure"
 	grpcConnErrEv        = "could not connect"
 	grpcLoginErrEv       = "login client err"
 	grpcAuthErrEv        = "Auth failed"
 	grpcSendErrEv        = "send RPC failure"
 	grpcHeaderErrEv      = "get header failure"
 	grpcDialOptsErrEv    = "dial options creations failure"
+	grpcRetryEv          = "session ended"
+	grpcEOFEv            = "stream closed by device"
+	grpcSubIDErrEv       = "parse subscription id failure"
+	grpcCancelErrEv      = "cancel subscription failure"
 	tlsLogTopic          = "tls"
 	tlsCAReadEv          = "failure read CA file"
 	tlsCertAppendEv      = "failure to append certs"
 	eventGRPCConnErr     = "grpc connection is nil"
+	gnmiTopic            = "gnmi"
+	gnmiReqErrEv         = "build subscribe request failure"
+	gnmiPollErrEv        = "send poll failure"
+	gnmiRespErrEv        = "subscribe response err"
+	gnmiJSONErrEv        = "json value decode err"
+	gnmiEOFEv            = "subscription ended"
+	gnmiOnceDoneEv       = "once subscription complete"
+	gnmiFilterEv         = "path filter is not supported, ignoring it"
+	dialoutTopic         = "dialout"
+	dialoutNoMatchEv     = "no device for stream"
+	dialoutDevErrEv      = "device reported error"
+	dialoutDecodeErrEv   = "decode telemetry failure"
+	udpTopic             = "udp"
+	udpBufErrEv          = "set read buffer failure"
+	udpDecodeErrEv       = "decode sensor failure"
+	udpUnknownSrcEv      = "unknown source"
+	shutdownTopic        = "shutdown"
+	apiStopErrEv         = "api shutdown failure"
+	outputTopic          = "output"
+	outputCfgErrEv       = "output config failure"
+	outputTypeErrEv      = "unknown output type"
+	outputNoneEv         = "no outputs configured, data is discarded"
+	outputWriteErrEv     = "write failure"
+	outputCloseErrEv     = "close failure"
+	outputSpoolErrEv     = "spool failure"
+	spoolTooBigEv        = "batch is bigger than the whole spool"
+	metricFirewall       = "firewall"
+	metricLsp            = "lsp_stats"
+	metricIfEvent        = "interface_event"
+	metricDevice         = "collector_device"
+	metricLatency        = "collector_latency"
+	metricQueue          = "queue_stats"
+	promTopic            = "prometheus"
+	rwTopic              = "remote_write"
+	rwRetryEv            = "push failure, retrying"
+	influx2Topic         = "influx2"
+	influx2LineErrEv     = "line rejected"
+	influx2PartialEv     = "partial write"
+	kafkaTopic           = "kafka"
+	kafkaTopicErrEv      = "topic template failure"
+	kafkaEncodeErrEv     = "encode record failure"
+	kafkaDeliveryErrEv   = "delivery failure"
+	metricOutput         = "collector_output"
+	tsTopic              = "timestamp"
+	tsSkewEv             = "device clock skewed, using collector time"
 )
 
 func logErrEvent(topic, event string, err error) {
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"sticoll/rest"
+	na_pb "sticoll/telemetry"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/viper"
+)
+
+const qos = "/qos/"
+
+// forwarding class maps of device groups from the [forwarding_classes] section, set once on start
+var fcGroups = make(map[string]map[string]string)
+
+func classesCfg() error {
+	for group, classes := range viper.GetStringMap("forwarding_classes") {
+		m, err := cast.ToStringMapStringE(classes)
+		if err != nil {
+			return err
+		}
+		for queue := range m {
+			if _, err := strconv.Atoi(queue); err != nil {
+				return fmt.Errorf("forwarding classes of %s: queue %q is not a number", group, queue)
+			}
+		}
+		fcGroups[group] = m
+	}
+	return nil
+}
+
+// forwardingClasses names the queues of a device. What is configured for
+// the device wins over its group and both win over what the device told us,
+// a partial CoS config on the device should not hide the one we were given.
+type forwardingClasses struct {
+	sync.RWMutex
+	device map[string]string
+	group  map[string]string
+	// OpenConfig puts a class into a queue by the queue name,
+	// the number of a queue comes separately with the queue
+	classQueues map[string]string
+	queueIDs    map[string]string
+	learned     map[string]string
+}
+
+func newForwardingClasses(cfg *rest.GRPCCfg) *forwardingClasses {
+	f := &forwardingClasses{
+		classQueues: make(map[string]string),
+		queueIDs:    make(map[string]string),
+		learned:     make(map[string]string),
+	}
+	f.configure(cfg)
+	return f
+}
+
+func (f *forwardingClasses) configure(cfg *rest.GRPCCfg) {
+	f.Lock()
+	defer f.Unlock()
+	f.device = cfg.ForwardingClasses
+	f.group = fcGroups[strings.ToLower(cfg.Group)]
+}
+
+// name of a queue, empty if nobody knows it
+func (f *forwardingClasses) name(queue string) string {
+	if f == nil {
+		return ""
+	}
+	f.RLock()
+	defer f.RUnlock()
+	if n, ok := f.device[queue]; ok {
+		return n
+	}
+	if n, ok := f.group[queue]; ok {
+		return n
+	}
+	return f.learned[queue]
+}
+
+// learnClass is a forwarding class and the name of its queue
+func (f *forwardingClasses) learnClass(class, queue string) {
+	if f == nil {
+		return
+	}
+	f.Lock()
+	defer f.Unlock()
+	f.classQueues[class] = queue
+	f.relearn()
+}
+
+// learnQueue is the name of a queue and its number
+func (f *forwardingClasses) learnQueue(queue, id string) {
+	if f == nil {
+		return
+	}
+	f.Lock()
+	defer f.Unlock()
+	f.queueIDs[queue] = id
+	f.relearn()
+}
+
+// relearn names queue numbers after the classes in them, a class moved
+// to another queue is no longer the name of the old one. Classes sharing
+// a queue are sorted so the queue keeps its name between walks.
+func (f *forwardingClasses) relearn() {
+	var classes []string
+	for class := range f.classQueues {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	f.learned = make(map[string]string)
+	for _, class := range classes {
+		id, ok := f.queueIDs[f.classQueues[class]]
+		if _, named := f.learned[id]; ok && !named {
+			f.learned[id] = class
+		}
+	}
+}
+
+// qos learns forwarding classes from OpenConfig QoS data,
+// forwarding-group[name='voice']/state/output-queue says voice uses the queue named EF
+// and queues/queue[name='EF']/state/queue-id that EF is queue 5
+func (s *interfaceStats) qos(ocData *na_pb.OpenConfigData) {
+	var prefix string
+	for _, kv := range ocData.Kv {
+		if kv.Key == "__prefix__" {
+			prefix = kv.GetStrValue()
+			continue
+		}
+		path := prefix + kv.Key
+		var (
+			elem  string
+			learn func(name, value string)
+		)
+		switch {
+		case strings.HasSuffix(path, "/output-queue"):
+			elem, learn = "forwarding-group[", s.classes.learnClass
+		case strings.HasSuffix(path, "/queue-id"):
+			elem, learn = "queues/queue[", s.classes.learnQueue
+		default:
+			continue
+		}
+		i := strings.Index(path, elem)
+		if i < 0 {
+			continue
+		}
+		name, err := prefixEntity(path[i:], defaultEntityAttr)
+		if err != nil {
+			logErrEvent(ifsLogTopic, eventParseFromPrxErr, err)
+			continue
+		}
+		learn(name, kvString(kv))
+	}
+}
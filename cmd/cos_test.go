@@ -0,0 +1,100 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"sticoll/rest"
+	na_pb "sticoll/telemetry"
+)
+
+func TestClassesCfg(t *testing.T) {
+	defer func(groups map[string]map[string]string) { fcGroups = groups }(fcGroups)
+	fcGroups = make(map[string]map[string]string)
+	useConfig(t, `
+[forwarding_classes.core]
+"0" = "best-effort"
+"3" = "network-control"`)
+	if err := classesCfg(); err != nil {
+		t.Fatal(err)
+	}
+	if fcGroups["core"]["3"] != "network-control" {
+		t.Errorf("got %v", fcGroups)
+	}
+
+	useConfig(t, `
+[forwarding_classes.core]
+"be" = "best-effort"`)
+	if err := classesCfg(); err == nil || !strings.Contains(err.Error(), "not a number") {
+		t.Errorf("got %v", err)
+	}
+}
+
+// qosData is what a device says about its forwarding groups and queues
+func qosData(kvs ...*na_pb.KeyValue) *na_pb.OpenConfigData {
+	return &na_pb.OpenConfigData{Path: qos, Kv: kvs}
+}
+
+func TestLearnClasses(t *testing.T) {
+	f := newForwardingClasses(&rest.GRPCCfg{})
+	s := newinterfaceStats(make(chan dataPoint, 1), f)
+	s.qos(qosData(
+		kvStr("__prefix__", "/qos/forwarding-groups/"),
+		kvStr("forwarding-group[name='voice']/state/output-queue", "EF"),
+		kvStr("forwarding-group[name='data']/state/output-queue", "BE"),
+		// both share a queue, the name does not depend on the order classes come in
+		kvStr("forwarding-group[name='bulk']/state/output-queue", "BE"),
+		kvStr("__prefix__", "/qos/"),
+		kvUint("queues/queue[name='EF']/state/queue-id", 5),
+	))
+	// a class is only a name once its queue has a number
+	if f.name("5") != "voice" || f.name("0") != "" {
+		t.Errorf("5: %q 0: %q", f.name("5"), f.name("0"))
+	}
+	s.qos(qosData(kvUint("queues/queue[name='BE']/state/queue-id", 0)))
+	if f.name("0") != "bulk" {
+		t.Errorf("0: %q", f.name("0"))
+	}
+
+	// a class moving to another queue takes its name along
+	s.qos(qosData(kvStr("forwarding-group[name='voice']/state/output-queue", "BE")))
+	if f.name("5") != "" || f.name("0") != "bulk" {
+		t.Errorf("after the move 5: %q 0: %q", f.name("5"), f.name("0"))
+	}
+}
+
+func TestClassPrecedence(t *testing.T) {
+	defer func(groups map[string]map[string]string) { fcGroups = groups }(fcGroups)
+	fcGroups = map[string]map[string]string{"core": {"0": "group-be", "1": "group-ef"}}
+	f := newForwardingClasses(&rest.GRPCCfg{Group: "Core", ForwardingClasses: map[string]string{"0": "device-be"}})
+	f.learnClass("learned-be", "BE")
+	f.learnQueue("BE", "0")
+	f.learnClass("learned-nc", "NC")
+	f.learnQueue("NC", "3")
+	tests := []struct {
+		queue, want string
+	}{
+		{"0", "device-be"},
+		{"1", "group-ef"},
+		{"3", "learned-nc"},
+		{"7", ""},
+	}
+	for _, tt := range tests {
+		if got := f.name(tt.queue); got != tt.want {
+			t.Errorf("queue %s: got %q, want %q", tt.queue, got, tt.want)
+		}
+	}
+
+	// a device leaving its group falls back to what was learned
+	f.configure(&rest.GRPCCfg{})
+	if f.name("0") != "learned-be" || f.name("1") != "" {
+		t.Errorf("after configure 0: %q 1: %q", f.name("0"), f.name("1"))
+	}
+
+	// sources without classes e.g. unknown UDP senders have none
+	var none *forwardingClasses
+	none.learnClass("x", "X")
+	if none.name("0") != "" {
+		t.Error("nil classes named a queue")
+	}
+}
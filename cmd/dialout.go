@@ -0,0 +1,431 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	gnmi_pb "sticoll/gnmi"
+	mdt_pb "sticoll/mdt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type dialoutSrv struct {
+	Addr    string
+	Port    string
+	TLSCert string
+	TLSKey  string
+	TLSCA   string
+	devices *deviceRegistry
+	mu      sync.Mutex
+	srv     *grpc.Server
+	// set on stop, from then on new streams are refused
+	stopping bool
+	// streams still decoding, waited for on stop so nothing writes points after
+	streams sync.WaitGroup
+}
+
+func newDialoutSrv(devices *deviceRegistry) *dialoutSrv {
+	return &dialoutSrv{
+		Addr:    viper.GetString("dialout.address"),
+		Port:    viper.GetString("dialout.port"),
+		TLSCert: viper.GetString("dialout.tls_cert"),
+		TLSKey:  viper.GetString("dialout.tls_key"),
+		TLSCA:   viper.GetString("dialout.tls_ca"),
+		devices: devices,
+	}
+}
+
+func (s *dialoutSrv) serverOpts() ([]grpc.ServerOption, error) {
+	if s.TLSCert == "" {
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(s.TLSCert, s.TLSKey)
+	if err != nil {
+		return nil, err
+	}
+	tlsCfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+	}
+	if s.TLSCA != "" {
+		caInBytes, err := ioutil.ReadFile(s.TLSCA)
+		if err != nil {
+			return nil, err
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caInBytes) {
+			return nil, errors.New("AppendCertsFromPEM err")
+		}
+		tlsCfg.ClientCAs = certPool
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsCfg))}, nil
+}
+
+// startDialout runs a gRPC server devices connect to, it blocks until the listener fails
+func (s *dialoutSrv) startDialout() error {
+	opts, err := s.serverOpts()
+	if err != nil {
+		return err
+	}
+	lis, err := net.Listen("tcp", s.Addr+":"+s.Port)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	if s.stopping {
+		s.mu.Unlock()
+		lis.Close()
+		return nil
+	}
+	s.srv = grpc.NewServer(opts...)
+	mdt_pb.RegisterGRPCMdtDialoutServer(s.srv, s)
+	s.mu.Unlock()
+	logInfoEvent(dialoutTopic, "listening", lis.Addr().String())
+	err = s.srv.Serve(lis)
+	if err == grpc.ErrServerStopped {
+		return nil
+	}
+	return err
+}
+
+// stop closes the listener and all streams and waits for handlers to return
+func (s *dialoutSrv) stop() {
+	s.mu.Lock()
+	s.stopping = true
+	srv := s.srv
+	s.mu.Unlock()
+	if srv == nil {
+		return
+	}
+	srv.Stop()
+	s.streams.Wait()
+}
+
+// match finds a device for a stream, TLS identity wins over the peer address
+// as devices behind NAT all come from the same address
+func (s *dialoutSrv) match(ctx context.Context) (*device, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("no peer info in stream context")
+	}
+	var ids []string
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.PeerCertificates) > 0 {
+		cert := tlsInfo.State.PeerCertificates[0]
+		ids = append(ids, cert.Subject.CommonName)
+		ids = append(ids, cert.DNSNames...)
+	}
+	addr, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return nil, err
+	}
+	if d := s.devices.byIdentity(ids); d != nil {
+		return d, nil
+	}
+	if d := s.devices.byAddr(addr); d != nil {
+		return d, nil
+	}
+	return nil, fmt.Errorf("no device registered for peer %s identity %s", addr, strings.Join(ids, ","))
+}
+
+//MdtDialout receives Cisco IOS XR model driven telemetry pushed by a router
+func (s *dialoutSrv) MdtDialout(stream mdt_pb.GRPCMdtDialout_MdtDialoutServer) error {
+	// a stream counted after stop started waiting would not be waited for
+	s.mu.Lock()
+	if s.stopping {
+		s.mu.Unlock()
+		return status.Error(codes.Unavailable, "shutting down")
+	}
+	s.streams.Add(1)
+	s.mu.Unlock()
+	defer s.streams.Done()
+	d, err := s.match(stream.Context())
+	if err != nil {
+		logErrEvent(dialoutTopic, dialoutNoMatchEv, err)
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	ctx, cancel := context.WithCancel(stream.Context())
+	st := &dialoutStream{cancel: cancel, done: make(chan struct{})}
+	defer close(st.done)
+	defer cancel()
+	if !d.attach(st) {
+		return status.Error(codes.PermissionDenied, "device removed")
+	}
+	defer d.detach(st)
+	// the device may be updated while a stream which came in after its stop runs
+	host := d.config().Host
+	ifStats := d.gnmiStats()
+	logged := make(map[string]bool)
+	logInfoEvent(dialoutTopic, "stream accepted", fmt.Sprintf("hostname: %s", host))
+	msgs := recvDialout(ctx, stream)
+	for {
+		var m dialoutMsg
+		select {
+		case <-ctx.Done():
+			d.Stats.setState(stateDisconnected)
+			if stream.Context().Err() != nil {
+				logInfoEvent(dialoutTopic, "stream closed", fmt.Sprintf("hostname: %s", host))
+				return nil
+			}
+			logInfoEvent(dialoutTopic, "stream stopped", fmt.Sprintf("hostname: %s", host))
+			return status.Error(codes.Unavailable, "device stopped")
+		case m = <-msgs:
+		}
+		args, err := m.args, m.err
+		if err == io.EOF {
+			d.Stats.setState(stateDisconnected)
+			logInfoEvent(dialoutTopic, "stream closed", fmt.Sprintf("hostname: %s", host))
+			return nil
+		}
+		if err != nil {
+			d.Stats.setState(stateDisconnected)
+			logErrEvent(dialoutTopic, eventRecvErr, err)
+			return err
+		}
+		if args.Errors != "" {
+			logErrEvent(dialoutTopic, dialoutDevErrEv, errors.New(args.Errors))
+		}
+		if len(args.Data) == 0 {
+			continue
+		}
+		d.Stats.inBytes(len(args.Data))
+		var t mdt_pb.Telemetry
+		err = proto.Unmarshal(args.Data, &t)
+		if err != nil {
+			d.Stats.decodeErr()
+			logErrEvent(dialoutTopic, dialoutDecodeErrEv, err)
+			continue
+		}
+		if len(t.GetDataGpb().GetRow()) > 0 {
+			d.Stats.decodeErr()
+			logErrEvent(dialoutTopic, dialoutDecodeErrEv, fmt.Errorf("compact GPB is not supported, use self-describing-gpb for %s", t.EncodingPath))
+			continue
+		}
+		d.Stats.received(seqKey{}, 0, len(t.DataGpbkv))
+		d.Stats.sample(t.EncodingPath, t.MsgTimestamp)
+		decoded := false
+		for _, n := range mdtToNotifications(&t) {
+			for dataType, ocData := range gnmiToOCData(n) {
+				if d.decodeGNMI(ifStats, dataType, ocData) {
+					decoded = true
+				}
+			}
+		}
+		// native models e.g. Cisco-IOS-XR-infra-statsd-oper are not decoded, only OpenConfig ones
+		if !decoded {
+			d.unsupported(logged, dialoutTopic, t.EncodingPath)
+		}
+	}
+}
+
+// dialoutStream is a stream of a device dialing in, stopping the device ends it
+type dialoutStream struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// attach ties a stream to its device, false if the device is gone already
+func (d *device) attach(st *dialoutStream) bool {
+	d.streamsMu.Lock()
+	defer d.streamsMu.Unlock()
+	cfg := d.config()
+	cfg.RLock()
+	removed := cfg.Removed
+	cfg.RUnlock()
+	if removed {
+		return false
+	}
+	if d.streams == nil {
+		d.streams = make(map[*dialoutStream]bool)
+	}
+	d.streams[st] = true
+	return true
+}
+
+func (d *device) detach(st *dialoutStream) {
+	d.streamsMu.Lock()
+	defer d.streamsMu.Unlock()
+	delete(d.streams, st)
+}
+
+// endStreams closes streams of the device and waits for their handlers to return
+func (d *device) endStreams() {
+	d.streamsMu.Lock()
+	streams := d.streams
+	d.streams = nil
+	d.streamsMu.Unlock()
+	for st := range streams {
+		st.cancel()
+	}
+	for st := range streams {
+		<-st.done
+	}
+}
+
+type dialoutMsg struct {
+	args *mdt_pb.MdtDialoutArgs
+	err  error
+}
+
+// recvDialout receives in the background as Recv can not be interrupted,
+// returning from the handler is what ends a stream on the server side
+func recvDialout(ctx context.Context, stream mdt_pb.GRPCMdtDialout_MdtDialoutServer) <-chan dialoutMsg {
+	msgs := make(chan dialoutMsg)
+	go func() {
+		for {
+			args, err := stream.Recv()
+			select {
+			case msgs <- dialoutMsg{args: args, err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return msgs
+}
+
+// mdtToNotifications converts key-value GPB rows into gNMI notifications,
+// each row has a "keys" and a "content" field and becomes one notification
+// so the same conversion and decoding as for gNMI targets applies
+func mdtToNotifications(t *mdt_pb.Telemetry) []*gnmi_pb.Notification {
+	var base []string
+	for _, e := range strings.Split(t.EncodingPath, "/") {
+		if e != "" {
+			base = append(base, e)
+		}
+	}
+	if len(base) == 0 {
+		return nil
+	}
+	var out []*gnmi_pb.Notification
+	for _, row := range t.DataGpbkv {
+		ts := row.Timestamp
+		if ts == 0 {
+			ts = t.MsgTimestamp
+		}
+		n := &gnmi_pb.Notification{
+			Timestamp: int64(ts) * int64(time.Millisecond),
+			Prefix:    &gnmi_pb.Path{Target: t.GetNodeIdStr()},
+		}
+		for _, e := range base {
+			n.Prefix.Elem = append(n.Prefix.Elem, &gnmi_pb.PathElem{Name: e})
+		}
+		for _, f := range row.Fields {
+			switch f.Name {
+			case "keys":
+				list := n.Prefix.Elem[mdtList(base)]
+				list.Key = make(map[string]string)
+				for _, k := range f.Fields {
+					list.Key[k.Name] = mdtFieldString(k)
+				}
+			case "content":
+				n.Update = append(n.Update, mdtUpdates(nil, f.Fields)...)
+			}
+		}
+		out = append(out, n)
+	}
+	return out
+}
+
+// mdtList is the index of the list a row is an element of, the keys of a row belong to it
+// and not to the containers under it e.g. interface of .../interface/state/counters.
+// OpenConfig lists are the only child of a container of their plural name,
+// without one the keys go on the last element.
+func mdtList(elems []string) int {
+	for i := len(elems) - 1; i > 0; i-- {
+		name, parent := stripModule(elems[i]), stripModule(elems[i-1])
+		if parent == name+"s" || parent == name+"es" || parent == strings.TrimSuffix(name, "y")+"ies" {
+			return i
+		}
+	}
+	return len(elems) - 1
+}
+
+func mdtUpdates(path []*gnmi_pb.PathElem, fields []*mdt_pb.TelemetryField) []*gnmi_pb.Update {
+	var updates []*gnmi_pb.Update
+	for _, f := range fields {
+		elems := append(append([]*gnmi_pb.PathElem{}, path...), &gnmi_pb.PathElem{Name: f.Name})
+		if len(f.Fields) > 0 {
+			updates = append(updates, mdtUpdates(elems, f.Fields)...)
+			continue
+		}
+		val := mdtFieldValue(f)
+		if val == nil {
+			continue
+		}
+		updates = append(updates, &gnmi_pb.Update{
+			Path: &gnmi_pb.Path{Elem: elems},
+			Val:  val,
+		})
+	}
+	return updates
+}
+
+func mdtFieldValue(f *mdt_pb.TelemetryField) *gnmi_pb.TypedValue {
+	tv := &gnmi_pb.TypedValue{}
+	switch v := f.ValueByType.(type) {
+	case *mdt_pb.TelemetryField_BytesValue:
+		tv.Value = &gnmi_pb.TypedValue_BytesVal{BytesVal: v.BytesValue}
+	case *mdt_pb.TelemetryField_StringValue:
+		tv.Value = &gnmi_pb.TypedValue_StringVal{StringVal: v.StringValue}
+	case *mdt_pb.TelemetryField_BoolValue:
+		tv.Value = &gnmi_pb.TypedValue_BoolVal{BoolVal: v.BoolValue}
+	case *mdt_pb.TelemetryField_Uint32Value:
+		tv.Value = &gnmi_pb.TypedValue_UintVal{UintVal: uint64(v.Uint32Value)}
+	case *mdt_pb.TelemetryField_Uint64Value:
+		tv.Value = &gnmi_pb.TypedValue_UintVal{UintVal: v.Uint64Value}
+	case *mdt_pb.TelemetryField_Sint32Value:
+		tv.Value = &gnmi_pb.TypedValue_IntVal{IntVal: int64(v.Sint32Value)}
+	case *mdt_pb.TelemetryField_Sint64Value:
+		tv.Value = &gnmi_pb.TypedValue_IntVal{IntVal: v.Sint64Value}
+	case *mdt_pb.TelemetryField_DoubleValue:
+		tv.Value = &gnmi_pb.TypedValue_DoubleVal{DoubleVal: v.DoubleValue}
+	case *mdt_pb.TelemetryField_FloatValue:
+		tv.Value = &gnmi_pb.TypedValue_FloatVal{FloatVal: v.FloatValue}
+	default:
+		return nil
+	}
+	return tv
+}
+
+func mdtFieldString(f *mdt_pb.TelemetryField) string {
+	switch v := f.ValueByType.(type) {
+	case *mdt_pb.TelemetryField_StringValue:
+		return v.StringValue
+	case *mdt_pb.TelemetryField_BytesValue:
+		return string(v.BytesValue)
+	case *mdt_pb.TelemetryField_BoolValue:
+		return fmt.Sprint(v.BoolValue)
+	case *mdt_pb.TelemetryField_Uint32Value:
+		return fmt.Sprint(v.Uint32Value)
+	case *mdt_pb.TelemetryField_Uint64Value:
+		return fmt.Sprint(v.Uint64Value)
+	case *mdt_pb.TelemetryField_Sint32Value:
+		return fmt.Sprint(v.Sint32Value)
+	case *mdt_pb.TelemetryField_Sint64Value:
+		return fmt.Sprint(v.Sint64Value)
+	case *mdt_pb.TelemetryField_DoubleValue:
+		return fmt.Sprint(v.DoubleValue)
+	case *mdt_pb.TelemetryField_FloatValue:
+		return fmt.Sprint(v.FloatValue)
+	}
+	return ""
+}
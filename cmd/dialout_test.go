@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	mdt_pb "sticoll/mdt"
+	"sticoll/rest"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// ifCounters is a self-describing GPB message of openconfig-interfaces:interfaces/interface/state/counters
+// with one row, keys name=GigabitEthernet0/0/0/0 and content in-octets, out-octets and in-errors
+const ifCounters = "32396f70656e636f6e6669672d696e74657266616365733a696e746572666163" +
+	"65732f696e746572666163652f73746174652f636f756e746572734007488080" +
+	"babbc82e508080babbc82e5a6a088580babbc82e7a2612046b6579737a1e1204" +
+	"6e616d652a164769676162697445746865726e6574302f302f302f307a391207" +
+	"636f6e74656e747a0e1209696e2d6f637465747340d2097a0f120a6f75742d6f" +
+	"637465747340ae2c7a0d1209696e2d6572726f72734000688a80babbc82e0a02" +
+	"72311a056f632d6966"
+
+func TestMdtList(t *testing.T) {
+	tests := []struct {
+		path string
+		want int
+	}{
+		{"openconfig-interfaces:interfaces/interface/state/counters", 1},
+		{"openconfig-interfaces:interfaces/interface", 1},
+		{"openconfig-interfaces:interfaces/interface/subinterfaces/subinterface/state", 3},
+		{"openconfig-network-instance:network-instances/network-instance/protocols/protocol/bgp/neighbors/neighbor/state", 6},
+		{"openconfig-platform:components/component/state", 1},
+		{"openconfig-qos:qos/interfaces/interface/output/queues/queue/state", 5},
+		{"openconfig-system:system/memory/state", 2},
+	}
+	for _, tt := range tests {
+		if got := mdtList(strings.Split(tt.path, "/")); got != tt.want {
+			t.Errorf("%s: got %d, want %d", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestMdtDecode(t *testing.T) {
+	useSensorsToml(t)
+	data, err := hex.DecodeString(ifCounters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tm mdt_pb.Telemetry
+	err = proto.Unmarshal(data, &tm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ns := mdtToNotifications(&tm)
+	if len(ns) != 1 {
+		t.Fatalf("%d notifications, want 1", len(ns))
+	}
+	if ns[0].Prefix.Target != "r1" {
+		t.Errorf("target %q", ns[0].Prefix.Target)
+	}
+	ocs := gnmiToOCData(ns[0])
+	got := make(map[string][]string)
+	for dataType, ocData := range ocs {
+		got[dataType] = ocDump(ocData)
+	}
+	want := map[string][]string{interfaces: {
+		"/interfaces/interface[name='GigabitEthernet0/0/0/0']/state/counters/in-errors=0",
+		"/interfaces/interface[name='GigabitEthernet0/0/0/0']/state/counters/in-octets=1234",
+		"/interfaces/interface[name='GigabitEthernet0/0/0/0']/state/counters/out-octets=5678",
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got\n%v\nwant\n%v", got, want)
+	}
+	d := &device{cfg: &rest.GRPCCfg{Host: "r1"}, pointCh: make(chan dataPoint, 100)}
+	ifStats := d.gnmiStats()
+	for dataType, ocData := range ocs {
+		if !d.decodeGNMI(ifStats, dataType, ocData) {
+			t.Errorf("%s not decoded", dataType)
+		}
+	}
+	var points []string
+	for len(d.pointCh) > 0 {
+		r := (<-d.pointCh).Record()
+		points = append(points, fmt.Sprintf("%s %v %v", r.Measurement, r.Tags, r.Fields))
+	}
+	wantPoints := []string{"phy_interface map[host:r1 name:GigabitEthernet0/0/0/0] map[counters_in_errors:0 counters_in_octets:1234 counters_out_octets:5678]"}
+	if !reflect.DeepEqual(points, wantPoints) {
+		t.Errorf("got\n%v\nwant\n%v", points, wantPoints)
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"time"
+)
+
+const (
+	fwTypeCounter = "counter"
+	fwTypePolicer = "policer"
+)
+
+//FirewallStats a filter counter or a policer out of spec counter
+type FirewallStats struct {
+	Host      string
+	Filter    string
+	Name      string
+	Type      string
+	Packets   int64
+	Bytes     int64
+	Timestamp time.Time
+}
+
+//Record turns stats into a record for outputs
+func (fw *FirewallStats) Record() Record {
+	tags := map[string]string{
+		"host":   fw.Host,
+		"filter": fw.Filter,
+		"name":   fw.Name,
+		"type":   fw.Type,
+	}
+	fields := map[string]interface{}{
+		"packets": fw.Packets,
+		"bytes":   fw.Bytes,
+	}
+	return Record{
+		Measurement: metricFirewall,
+		Tags:        tags,
+		Fields:      fields,
+		Timestamp:   fw.Timestamp,
+	}
+}
@@ -0,0 +1,506 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	gnmi_pb "sticoll/gnmi"
+	"sticoll/rest"
+	na_pb "sticoll/telemetry"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	protoGNMI     = "gnmi"
+	gnmiModeOnce  = "once"
+	gnmiModePoll  = "poll"
+	gnmiEncJSON   = "json"
+	gnmiEncIETF   = "json_ietf"
+	gnmiEncProto  = "proto"
+	gnmiEncASCII  = "ascii"
+	gnmiEncBytes  = "bytes"
+	defaultPollMs = 10000
+	// sensors under it are Junos native ones, only JTI streams them
+	junosNative = "/junos/"
+)
+
+// gNMI does not split data into sensors the way Juniper does.
+// Every update carries a full path, so to reuse the interfaceStats aggregation
+// each notification is regrouped into na_pb.OpenConfigData packets.
+// The entity prefix is the path up to and including the last keyed element
+// e.g. /interfaces/interface[name='Ethernet1']/ and the rest of the path becomes the key,
+// which is exactly how Juniper sends /interfaces/ data.
+func (d *device) gnmiSubscribe(ctx context.Context, conn *grpc.ClientConn) error {
+	if conn == nil {
+		return errors.New(eventGRPCConnErr)
+	}
+	req, err := d.gnmiSubscribeRequest()
+	if err != nil {
+		return fmt.Errorf("%s: %v", gnmiReqErrEv, err)
+	}
+	if d.cfg.User != "" {
+		md := metadata.New(map[string]string{
+			"username": d.cfg.User,
+			"password": d.cfg.Password,
+		})
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+	// the stream and with it polling end as soon as receiving does
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := gnmi_pb.NewGNMIClient(conn).Subscribe(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %v", grpcSendErrEv, err)
+	}
+	err = stream.Send(req)
+	if err != nil {
+		return fmt.Errorf("%s: %v", grpcSendErrEv, err)
+	}
+	d.transition(stateSubscribed)
+	if req.GetSubscribe().Mode == gnmi_pb.SubscriptionList_POLL {
+		go d.gnmiPoll(stream)
+	}
+	return d.gnmiSendAndReceive(stream)
+}
+
+func (d *device) gnmiPoll(stream gnmi_pb.GNMI_SubscribeClient) {
+	interval := d.cfg.GNMI.PollInterval
+	if interval == 0 {
+		interval = defaultPollMs
+	}
+	ticker := time.NewTicker(time.Duration(interval) * time.Millisecond)
+	defer ticker.Stop()
+	// the session is over once the stream is, a poll then would only fail
+	for {
+		select {
+		case <-stream.Context().Done():
+			return
+		case <-ticker.C:
+		}
+		err := stream.Send(&gnmi_pb.SubscribeRequest{
+			Request: &gnmi_pb.SubscribeRequest_Poll{Poll: &gnmi_pb.Poll{}},
+		})
+		if err != nil {
+			logErrEvent(gnmiTopic, gnmiPollErrEv, err)
+			return
+		}
+	}
+}
+
+func (d *device) gnmiSubscribeRequest() (*gnmi_pb.SubscribeRequest, error) {
+	sl := &gnmi_pb.SubscriptionList{
+		Prefix: &gnmi_pb.Path{
+			Origin: d.cfg.GNMI.Origin,
+			Target: d.cfg.GNMI.Target,
+		},
+	}
+	switch strings.ToLower(d.cfg.GNMI.Mode) {
+	case gnmiModeOnce:
+		sl.Mode = gnmi_pb.SubscriptionList_ONCE
+	case gnmiModePoll:
+		sl.Mode = gnmi_pb.SubscriptionList_POLL
+	default:
+		sl.Mode = gnmi_pb.SubscriptionList_STREAM
+	}
+	switch strings.ToLower(d.cfg.GNMI.Encoding) {
+	case gnmiEncIETF:
+		sl.Encoding = gnmi_pb.Encoding_JSON_IETF
+	case gnmiEncProto:
+		sl.Encoding = gnmi_pb.Encoding_PROTO
+	case gnmiEncASCII:
+		sl.Encoding = gnmi_pb.Encoding_ASCII
+	case gnmiEncBytes:
+		sl.Encoding = gnmi_pb.Encoding_BYTES
+	default:
+		sl.Encoding = gnmi_pb.Encoding_JSON
+	}
+	for _, p := range d.cfg.Paths {
+		path, err := parseGNMIPath(p.Path)
+		if err != nil {
+			return nil, err
+		}
+		sub := &gnmi_pb.Subscription{
+			Path: path,
+			Mode: gnmi_pb.SubscriptionMode_TARGET_DEFINED,
+		}
+		switch {
+		case p.Mode == rest.ModeOnChange:
+			sub.Mode = gnmi_pb.SubscriptionMode_ON_CHANGE
+		case p.Freq != 0:
+			sub.Mode = gnmi_pb.SubscriptionMode_SAMPLE
+			// Freq is in ms like for Juniper, gNMI wants ns
+			sub.SampleInterval = p.Freq * uint64(time.Millisecond)
+		}
+		if p.SuppressUnchanged {
+			sub.SuppressRedundant = true
+			sub.HeartbeatInterval = p.MaxSilentInterval * uint64(time.Millisecond)
+		}
+		// gNMI has no per path filter or EOM, paths have to be specific enough
+		if p.Filter != "" {
+			logInfoEvent(gnmiTopic, gnmiFilterEv, p.Path)
+		}
+		sl.Subscription = append(sl.Subscription, sub)
+	}
+	return &gnmi_pb.SubscribeRequest{
+		Request: &gnmi_pb.SubscribeRequest_Subscribe{Subscribe: sl},
+	}, nil
+}
+
+// gnmiSendAndReceive returns nil once a ONCE subscription has sent all of its data,
+// for the other modes the stream ending is always an error
+func (d *device) gnmiSendAndReceive(stream gnmi_pb.GNMI_SubscribeClient) error {
+	ifStats := d.gnmiStats()
+	logged := make(map[string]bool)
+	once := strings.ToLower(d.cfg.GNMI.Mode) == gnmiModeOnce
+	synced := false
+	logInfoEvent(gnmiTopic, "subscribed and waiting for new data", fmt.Sprintf("hostname: %s port: %d", d.cfg.Host, d.cfg.Port))
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			// the target closes a ONCE subscription after its sync response
+			if once && synced {
+				logInfoEvent(gnmiTopic, gnmiOnceDoneEv, fmt.Sprintf("hostname: %s port: %d", d.cfg.Host, d.cfg.Port))
+				return nil
+			}
+			return errors.New(gnmiEOFEv)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %v", eventRecvErr, err)
+		}
+		switch r := resp.Response.(type) {
+		case *gnmi_pb.SubscribeResponse_SyncResponse:
+			synced = true
+			logInfoEvent(gnmiTopic, eventSyncRespRecv, "")
+		case *gnmi_pb.SubscribeResponse_Error:
+			logErrEvent(gnmiTopic, gnmiRespErrEv, errors.New(r.Error.GetMessage()))
+		case *gnmi_pb.SubscribeResponse_Update:
+			// gNMI does not number notifications
+			d.Stats.received(seqKey{}, 0, len(r.Update.Update))
+			for dataType, ocData := range gnmiToOCData(r.Update) {
+				d.Stats.sample(dataType, ocData.Timestamp)
+				if !d.decodeGNMI(ifStats, dataType, ocData) {
+					d.unsupported(logged, gnmiTopic, dataType)
+				}
+			}
+		}
+	}
+}
+
+// gnmiStats decodes regrouped gNMI data through the same schemas as Juniper sensors.
+// gNMI and MDT carry OpenConfig models, Junos native sensors such as the linecard
+// ones never show up so entities do not wait for them.
+func (d *device) gnmiStats() *interfaceStats {
+	ifStats := newinterfaceStats(d.pointCh, d.classes)
+	ifStats.sensors = make(map[string]bool)
+	for path := range schemas.sensors {
+		if !strings.HasPrefix(path, junosNative) {
+			ifStats.sensors[path] = true
+		}
+	}
+	return ifStats
+}
+
+// decodeGNMI is false for data types nothing knows how to decode
+func (d *device) decodeGNMI(ifStats *interfaceStats, dataType string, ocData *na_pb.OpenConfigData) bool {
+	if dataType == qos {
+		ifStats.qos(ocData)
+	}
+	_, ok := schemas.sensors[dataType]
+	if ok {
+		// a dial-out stream coming in while the device is updated decodes here too
+		host := d.config().Host
+		// gNMI wants deletes applied before updates of the same notification,
+		// every notification is a walk of its own as there is no EOM
+		ifStats.deletes(dataType, ocData, host)
+		ifStats.decode(dataType, ocData, host)
+	}
+	return ok || dataType == qos
+}
+
+// unsupported counts data which is dropped as a decode error,
+// what it was is logged once per stream so operators see why a device has no data
+func (d *device) unsupported(logged map[string]bool, topic, path string) {
+	d.Stats.decodeErr()
+	if logged[path] {
+		return
+	}
+	logged[path] = true
+	logErrEvent(topic, eventUnsupported, fmt.Errorf("hostname: %s path: %s", d.config().Host, path))
+}
+
+// gnmiLeaf is a single value with its full path split into
+// the entity prefix and the key relative to it
+type gnmiLeaf struct {
+	prefix string
+	kv     *na_pb.KeyValue
+}
+
+// gnmiToOCData regroups a notification into OpenConfigData packets keyed by data type,
+// see gnmiDataType
+func gnmiToOCData(n *gnmi_pb.Notification) map[string]*na_pb.OpenConfigData {
+	out := make(map[string]*na_pb.OpenConfigData)
+	get := func(dataType string) *na_pb.OpenConfigData {
+		ocData, ok := out[dataType]
+		if !ok {
+			ocData = &na_pb.OpenConfigData{
+				SystemId:  n.GetPrefix().GetTarget(),
+				Path:      dataType,
+				Timestamp: uint64(n.Timestamp / int64(time.Millisecond)),
+			}
+			out[dataType] = ocData
+		}
+		return ocData
+	}
+	prefix := n.GetPrefix().GetElem()
+	lastPrefix := make(map[string]string)
+	for _, u := range n.Update {
+		elems := append(append([]*gnmi_pb.PathElem{}, prefix...), u.GetPath().GetElem()...)
+		dataType, leaves := gnmiLeaves(elems, u.GetVal())
+		if dataType == "" {
+			continue
+		}
+		ocData := get(dataType)
+		for _, l := range leaves {
+			if lastPrefix[dataType] != l.prefix {
+				ocData.Kv = append(ocData.Kv, &na_pb.KeyValue{
+					Key:   "__prefix__",
+					Value: &na_pb.KeyValue_StrValue{StrValue: l.prefix},
+				})
+				lastPrefix[dataType] = l.prefix
+			}
+			ocData.Kv = append(ocData.Kv, l.kv)
+		}
+	}
+	for _, p := range n.Delete {
+		elems := append(append([]*gnmi_pb.PathElem{}, prefix...), p.GetElem()...)
+		if len(elems) == 0 {
+			continue
+		}
+		ocData := get(gnmiDataType(elems))
+		ocData.Delete = append(ocData.Delete, &na_pb.Delete{Path: gnmiPathString(elems)})
+	}
+	return out
+}
+
+// gnmiDataType is the sensor of the schema a path belongs to, the longest sensor path
+// in front of the path without its keys e.g. /network-instances/network-instance/protocols/protocol/bgp/
+// for BGP neighbors. Paths no sensor describes go by their top level container.
+func gnmiDataType(elems []*gnmi_pb.PathElem) string {
+	var b strings.Builder
+	b.WriteString("/")
+	for _, e := range elems {
+		b.WriteString(stripModule(e.Name))
+		b.WriteString("/")
+	}
+	path := b.String()
+	dataType := "/" + stripModule(elems[0].Name) + "/"
+	for sensor := range schemas.sensors {
+		if strings.HasPrefix(path, sensor) && len(sensor) > len(dataType) {
+			dataType = sensor
+		}
+	}
+	return dataType
+}
+
+// gnmiLeaves turns one update into leaves, a JSON encoded container
+// is flattened so every scalar inside becomes a leaf of its own
+func gnmiLeaves(elems []*gnmi_pb.PathElem, val *gnmi_pb.TypedValue) (string, []gnmiLeaf) {
+	if len(elems) == 0 || val == nil {
+		return "", nil
+	}
+	dataType := gnmiDataType(elems)
+	// keys of nested lists e.g. a subinterface index stay in the prefix,
+	// keys are relative to the entity so the schema finds them either way
+	split := len(elems)
+	for i := len(elems) - 1; i >= 0; i-- {
+		if len(elems[i].Key) > 0 {
+			split = i + 1
+			break
+		}
+	}
+	prefix := gnmiPathString(elems[:split])
+	var rel []string
+	for _, e := range elems[split:] {
+		rel = append(rel, stripModule(e.Name))
+	}
+	key := strings.Join(rel, "/")
+	var leaves []gnmiLeaf
+	switch v := val.Value.(type) {
+	case *gnmi_pb.TypedValue_JsonVal:
+		leaves = jsonLeaves(prefix, key, v.JsonVal)
+	case *gnmi_pb.TypedValue_JsonIetfVal:
+		leaves = jsonLeaves(prefix, key, v.JsonIetfVal)
+	default:
+		kv := typedValueToKV(val)
+		if kv == nil {
+			return dataType, nil
+		}
+		kv.Key = key
+		leaves = append(leaves, gnmiLeaf{prefix: prefix, kv: kv})
+	}
+	return dataType, leaves
+}
+
+func typedValueToKV(val *gnmi_pb.TypedValue) *na_pb.KeyValue {
+	kv := &na_pb.KeyValue{}
+	switch v := val.Value.(type) {
+	case *gnmi_pb.TypedValue_StringVal:
+		kv.Value = &na_pb.KeyValue_StrValue{StrValue: v.StringVal}
+	case *gnmi_pb.TypedValue_AsciiVal:
+		kv.Value = &na_pb.KeyValue_StrValue{StrValue: v.AsciiVal}
+	case *gnmi_pb.TypedValue_IntVal:
+		kv.Value = &na_pb.KeyValue_IntValue{IntValue: v.IntVal}
+	case *gnmi_pb.TypedValue_UintVal:
+		kv.Value = &na_pb.KeyValue_UintValue{UintValue: v.UintVal}
+	case *gnmi_pb.TypedValue_BoolVal:
+		kv.Value = &na_pb.KeyValue_BoolValue{BoolValue: v.BoolVal}
+	case *gnmi_pb.TypedValue_FloatVal:
+		kv.Value = &na_pb.KeyValue_DoubleValue{DoubleValue: float64(v.FloatVal)}
+	case *gnmi_pb.TypedValue_DoubleVal:
+		kv.Value = &na_pb.KeyValue_DoubleValue{DoubleValue: v.DoubleVal}
+	case *gnmi_pb.TypedValue_DecimalVal:
+		f := float64(v.DecimalVal.Digits)
+		for i := uint32(0); i < v.DecimalVal.Precision; i++ {
+			f = f / 10
+		}
+		kv.Value = &na_pb.KeyValue_DoubleValue{DoubleValue: f}
+	case *gnmi_pb.TypedValue_BytesVal:
+		kv.Value = &na_pb.KeyValue_BytesValue{BytesValue: v.BytesVal}
+	default:
+		return nil
+	}
+	return kv
+}
+
+func jsonLeaves(prefix, key string, data []byte) []gnmiLeaf {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	err := dec.Decode(&v)
+	if err != nil {
+		logErrEvent(gnmiTopic, gnmiJSONErrEv, err)
+		return nil
+	}
+	var leaves []gnmiLeaf
+	var walk func(key string, v interface{})
+	walk = func(key string, v interface{}) {
+		kv := &na_pb.KeyValue{Key: key}
+		switch t := v.(type) {
+		case map[string]interface{}:
+			for k, child := range t {
+				k = stripModule(k)
+				if key != "" {
+					k = key + "/" + k
+				}
+				walk(k, child)
+			}
+			return
+		case json.Number:
+			if i, err := t.Int64(); err == nil {
+				kv.Value = &na_pb.KeyValue_IntValue{IntValue: i}
+			} else if f, err := t.Float64(); err == nil {
+				kv.Value = &na_pb.KeyValue_DoubleValue{DoubleValue: f}
+			} else {
+				kv.Value = &na_pb.KeyValue_StrValue{StrValue: t.String()}
+			}
+		case string:
+			kv.Value = &na_pb.KeyValue_StrValue{StrValue: t}
+		case bool:
+			kv.Value = &na_pb.KeyValue_BoolValue{BoolValue: t}
+		default:
+			// lists and nulls can not be turned into a single leaf
+			return
+		}
+		leaves = append(leaves, gnmiLeaf{prefix: prefix, kv: kv})
+	}
+	walk(key, v)
+	return leaves
+}
+
+// stripModule removes a YANG module name e.g. openconfig-interfaces:interfaces
+func stripModule(name string) string {
+	if i := strings.Index(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// gnmiPathString formats elems the way Juniper does in __prefix__ values
+// e.g. /interfaces/interface[name='ge-0/0/0']/
+func gnmiPathString(elems []*gnmi_pb.PathElem) string {
+	var b strings.Builder
+	b.WriteString("/")
+	for _, e := range elems {
+		b.WriteString(stripModule(e.Name))
+		for _, k := range sortedKeys(e.Key) {
+			fmt.Fprintf(&b, "[%s='%s']", k, e.Key[k])
+		}
+		b.WriteString("/")
+	}
+	return b.String()
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// parseGNMIPath turns /interfaces/interface[name=Ethernet1]/state into a gNMI path
+func parseGNMIPath(p string) (*gnmi_pb.Path, error) {
+	path := &gnmi_pb.Path{}
+	var elem strings.Builder
+	var inKey bool
+	var elems []string
+	for _, r := range strings.Trim(p, "/") {
+		switch {
+		case r == '[':
+			inKey = true
+		case r == ']':
+			inKey = false
+		case r == '/' && !inKey:
+			elems = append(elems, elem.String())
+			elem.Reset()
+			continue
+		}
+		elem.WriteRune(r)
+	}
+	if inKey {
+		return nil, fmt.Errorf("unterminated key in path %s", p)
+	}
+	if elem.Len() > 0 {
+		elems = append(elems, elem.String())
+	}
+	for _, e := range elems {
+		pe := &gnmi_pb.PathElem{}
+		keyStart := strings.Index(e, "[")
+		if keyStart < 0 {
+			pe.Name = e
+			path.Elem = append(path.Elem, pe)
+			continue
+		}
+		pe.Name = e[:keyStart]
+		pe.Key = make(map[string]string)
+		for _, kv := range strings.Split(strings.TrimSuffix(e[keyStart+1:], "]"), "][") {
+			eq := strings.Index(kv, "=")
+			if eq < 0 {
+				return nil, fmt.Errorf("bad key %s in path %s", kv, p)
+			}
+			pe.Key[kv[:eq]] = strings.Trim(kv[eq+1:], "'\"")
+		}
+		path.Elem = append(path.Elem, pe)
+	}
+	return path, nil
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"sort"
+	"testing"
+
+	gnmi_pb "sticoll/gnmi"
+	"sticoll/rest"
+	na_pb "sticoll/telemetry"
+)
+
+const bgpSensor = "/network-instances/network-instance/protocols/protocol/bgp/"
+
+func gnmiPath(t *testing.T, p string) *gnmi_pb.Path {
+	path, err := parseGNMIPath(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func gnmiUint(path *gnmi_pb.Path, v uint64) *gnmi_pb.Update {
+	return &gnmi_pb.Update{Path: path, Val: &gnmi_pb.TypedValue{Value: &gnmi_pb.TypedValue_UintVal{UintVal: v}}}
+}
+
+func gnmiString(path *gnmi_pb.Path, v string) *gnmi_pb.Update {
+	return &gnmi_pb.Update{Path: path, Val: &gnmi_pb.TypedValue{Value: &gnmi_pb.TypedValue_StringVal{StringVal: v}}}
+}
+
+// ocDump is every KV as <prefix><key>=<value> and every delete, in any order
+func ocDump(ocData *na_pb.OpenConfigData) []string {
+	var out []string
+	prefix := ""
+	for _, kv := range ocData.Kv {
+		if kv.Key == "__prefix__" {
+			prefix = kvString(kv)
+			continue
+		}
+		out = append(out, prefix+kv.Key+"="+kvString(kv))
+	}
+	for _, del := range ocData.Delete {
+		out = append(out, "delete "+del.Path)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestParseGNMIPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+		err  bool
+	}{
+		{"/interfaces/interface/state/counters", "/interfaces/interface/state/counters/", false},
+		{"/interfaces/interface[name=Ethernet1]/state", "/interfaces/interface[name='Ethernet1']/state/", false},
+		{"interfaces/interface[name='ge-0/0/0']/", "/interfaces/interface[name='ge-0/0/0']/", false},
+		{"/network-instances/network-instance[name=default]/protocols/protocol[identifier=BGP][name=BGP]/bgp",
+			"/network-instances/network-instance[name='default']/protocols/protocol[identifier='BGP'][name='BGP']/bgp/", false},
+		{"/interfaces/interface[name=Ethernet1/state", "", true},
+		{"/interfaces/interface[Ethernet1]/state", "", true},
+	}
+	for _, tt := range tests {
+		path, err := parseGNMIPath(tt.path)
+		if (err != nil) != tt.err {
+			t.Errorf("%s: error %v", tt.path, err)
+			continue
+		}
+		if err == nil && gnmiPathString(path.Elem) != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.path, gnmiPathString(path.Elem), tt.want)
+		}
+	}
+}
+
+func TestGNMIToOCData(t *testing.T) {
+	useSensorsToml(t)
+	tests := []struct {
+		name string
+		n    *gnmi_pb.Notification
+		want map[string][]string
+	}{
+		{
+			name: "leaves of an interface",
+			n: &gnmi_pb.Notification{
+				Prefix: gnmiPath(t, "/interfaces/interface[name=Ethernet1]"),
+				Update: []*gnmi_pb.Update{
+					gnmiUint(gnmiPath(t, "state/counters/in-octets"), 5),
+					gnmiString(gnmiPath(t, "state/oper-status"), "UP"),
+				},
+			},
+			want: map[string][]string{interfaces: {
+				"/interfaces/interface[name='Ethernet1']/state/counters/in-octets=5",
+				"/interfaces/interface[name='Ethernet1']/state/oper-status=UP",
+			}},
+		},
+		{
+			name: "keys of nested lists stay in the prefix",
+			n: &gnmi_pb.Notification{
+				Update: []*gnmi_pb.Update{
+					gnmiUint(gnmiPath(t, "/interfaces/interface[name=Ethernet1]/subinterfaces/subinterface[index=100]/state/counters/in-octets"), 7),
+					gnmiUint(gnmiPath(t, "/interfaces/interface[name=Ethernet1]/subinterfaces/subinterface[index=200]/state/counters/in-octets"), 9),
+				},
+			},
+			want: map[string][]string{interfaces: {
+				"/interfaces/interface[name='Ethernet1']/subinterfaces/subinterface[index='100']/state/counters/in-octets=7",
+				"/interfaces/interface[name='Ethernet1']/subinterfaces/subinterface[index='200']/state/counters/in-octets=9",
+			}},
+		},
+		{
+			name: "BGP neighbors go to the BGP sensor",
+			n: &gnmi_pb.Notification{
+				Prefix: gnmiPath(t, "/network-instances/network-instance[name=default]/protocols/protocol[identifier=BGP][name=BGP]/bgp/neighbors/neighbor[neighbor-address=10.0.0.2]"),
+				Update: []*gnmi_pb.Update{
+					gnmiString(gnmiPath(t, "state/session-state"), "ESTABLISHED"),
+					gnmiUint(gnmiPath(t, "afi-safis/afi-safi[afi-safi-name=IPV4_UNICAST]/state/prefixes/received"), 10),
+				},
+			},
+			want: map[string][]string{bgpSensor: {
+				"/network-instances/network-instance[name='default']/protocols/protocol[identifier='BGP'][name='BGP']/bgp/neighbors/neighbor[neighbor-address='10.0.0.2']/afi-safis/afi-safi[afi-safi-name='IPV4_UNICAST']/state/prefixes/received=10",
+				"/network-instances/network-instance[name='default']/protocols/protocol[identifier='BGP'][name='BGP']/bgp/neighbors/neighbor[neighbor-address='10.0.0.2']/state/session-state=ESTABLISHED",
+			}},
+		},
+		{
+			name: "JSON containers are flattened without module names",
+			n: &gnmi_pb.Notification{
+				Update: []*gnmi_pb.Update{{
+					Path: gnmiPath(t, "/openconfig-interfaces:interfaces/interface[name=Ethernet1]/state"),
+					Val: &gnmi_pb.TypedValue{Value: &gnmi_pb.TypedValue_JsonIetfVal{
+						JsonIetfVal: []byte(`{"openconfig-interfaces:oper-status":"UP","counters":{"in-octets":"12","out-octets":3},"list":[1,2]}`),
+					}},
+				}},
+			},
+			want: map[string][]string{interfaces: {
+				"/interfaces/interface[name='Ethernet1']/state/counters/in-octets=12",
+				"/interfaces/interface[name='Ethernet1']/state/counters/out-octets=3",
+				"/interfaces/interface[name='Ethernet1']/state/oper-status=UP",
+			}},
+		},
+		{
+			name: "deletes",
+			n: &gnmi_pb.Notification{
+				Prefix: gnmiPath(t, "/interfaces"),
+				Delete: []*gnmi_pb.Path{gnmiPath(t, "interface[name=Ethernet1]")},
+			},
+			want: map[string][]string{interfaces: {
+				"delete /interfaces/interface[name='Ethernet1']/",
+			}},
+		},
+		{
+			name: "paths without a sensor go by their container",
+			n: &gnmi_pb.Notification{
+				Update: []*gnmi_pb.Update{gnmiString(gnmiPath(t, "/system/state/hostname"), "r1")},
+			},
+			want: map[string][]string{"/system/": {
+				"/system/state/hostname/=r1",
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make(map[string][]string)
+			for dataType, ocData := range gnmiToOCData(tt.n) {
+				if ocData.Path != dataType {
+					t.Errorf("data type %s in a packet of %s", dataType, ocData.Path)
+				}
+				got[dataType] = ocDump(ocData)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got\n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+// gnmiStream replays responses, then ends with err
+type gnmiStream struct {
+	gnmi_pb.GNMI_SubscribeClient
+	resps []*gnmi_pb.SubscribeResponse
+	err   error
+}
+
+func (s *gnmiStream) Recv() (*gnmi_pb.SubscribeResponse, error) {
+	if len(s.resps) == 0 {
+		return nil, s.err
+	}
+	r := s.resps[0]
+	s.resps = s.resps[1:]
+	return r, nil
+}
+
+func TestGNMISessionEnd(t *testing.T) {
+	useSensorsToml(t)
+	update := &gnmi_pb.SubscribeResponse{Response: &gnmi_pb.SubscribeResponse_Update{Update: &gnmi_pb.Notification{
+		Update: []*gnmi_pb.Update{gnmiUint(gnmiPath(t, "/interfaces/interface[name=Ethernet1]/state/counters/in-octets"), 5)},
+	}}}
+	sync := &gnmi_pb.SubscribeResponse{Response: &gnmi_pb.SubscribeResponse_SyncResponse{SyncResponse: true}}
+	broken := errors.New("broken")
+	tests := []struct {
+		name  string
+		mode  string
+		resps []*gnmi_pb.SubscribeResponse
+		err   error
+		ok    bool
+	}{
+		{"once complete", gnmiModeOnce, []*gnmi_pb.SubscribeResponse{update, sync}, io.EOF, true},
+		{"once closed before sync", gnmiModeOnce, []*gnmi_pb.SubscribeResponse{update}, io.EOF, false},
+		{"once broken after sync", gnmiModeOnce, []*gnmi_pb.SubscribeResponse{update, sync}, broken, false},
+		{"stream closed after sync", "stream", []*gnmi_pb.SubscribeResponse{update, sync}, io.EOF, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &device{
+				cfg:     &rest.GRPCCfg{Host: "r1", GNMI: rest.GNMICfg{Mode: tt.mode}},
+				pointCh: make(chan dataPoint, 100),
+			}
+			err := d.gnmiSendAndReceive(&gnmiStream{resps: append([]*gnmi_pb.SubscribeResponse{}, tt.resps...), err: tt.err})
+			if (err == nil) != tt.ok {
+				t.Errorf("got %v", err)
+			}
+		})
+	}
+}
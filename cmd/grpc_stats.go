@@ -4,10 +4,33 @@ import (
 	"sync"
 	"time"
 
+	"sticoll/rest"
+
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/stats"
 )
 
+// device connection states as shown by the status API
+const (
+	stateConnecting   = "connecting"
+	stateConnected    = "connected"
+	stateSubscribed   = "subscribed"
+	stateStreaming    = "streaming"
+	stateDisconnected = "disconnected"
+	loginOK           = "ok"
+	loginFailed       = "failed"
+)
+
+const (
+	defaultMonitorInterval = time.Minute
+	// late messages further back than this are not waited for any more
+	seqResetWindow = 1000
+	// a message repeated from further back than this is a sensor numbering again, not a dup
+	seqDupWindow = 64
+	// at most this many skipped sequence numbers are remembered per stream
+	seqMissingMax = 1024
+)
+
 type gRPCStats struct {
 	sync.Mutex               // guarding following stats
 	startTime                time.Time
@@ -16,9 +39,221 @@ type gRPCStats struct {
 	totalInPayloadLength     uint64
 	totalInPayloadWireLength uint64
 	totalInHeaderWireLength  uint64
-	totalLatency             uint64
-	totalLatencyPkt          uint64
 	totalDdrops              uint64
+	totalGaps                uint64
+	totalReorders            uint64
+	totalDups                uint64
+	// sequence numbers seen per sensor stream
+	seqs map[seqKey]*seqState
+	// export latency per sensor
+	latency map[string]*latencyHist
+	// connection life cycle
+	state        string
+	loginResult  string
+	lastMsg      time.Time
+	decodeErrors uint64
+	reconnects   uint64
+	dialed       bool
+}
+
+// seqKey identifies a stream of messages sharing a sequence counter on the device
+type seqKey struct {
+	systemID       string
+	componentID    uint32
+	subComponentID uint32
+	path           string
+}
+
+// seqState is the last sequence number of a stream and the ones
+// skipped on the way which may still arrive late
+type seqState struct {
+	first   uint64
+	last    uint64
+	missing map[uint64]bool
+	// the last message counted as a dup, 0 if the last one was not
+	dup uint64
+}
+
+// received accounts a message, sources which do not number messages pass 0
+func (st *gRPCStats) received(key seqKey, seq uint64, kvs int) {
+	st.Lock()
+	defer st.Unlock()
+	st.totalIn++
+	st.totalKV += uint64(kvs)
+	st.lastMsg = time.Now()
+	st.state = stateStreaming
+	if seq == 0 {
+		return
+	}
+	if st.seqs == nil {
+		st.seqs = make(map[seqKey]*seqState)
+	}
+	seqs, ok := st.seqs[key]
+	if !ok {
+		st.seqs[key] = &seqState{first: seq, last: seq}
+		return
+	}
+	dup := seqs.dup
+	seqs.dup = 0
+	switch {
+	case seq == seqs.last+1:
+		seqs.last = seq
+	case seq > seqs.last+1:
+		st.totalGaps++
+		st.totalDdrops += seq - seqs.last - 1
+		// anything this old is not going to show up any more
+		for missing := range seqs.missing {
+			if seq-missing > seqResetWindow {
+				delete(seqs.missing, missing)
+			}
+		}
+		for missing := seqs.last + 1; missing < seq && len(seqs.missing) < seqMissingMax; missing++ {
+			if seqs.missing == nil {
+				seqs.missing = make(map[uint64]bool)
+			}
+			seqs.missing[missing] = true
+		}
+		seqs.last = seq
+	case seqs.missing[seq]:
+		// a late message was counted as dropped when its gap was seen
+		delete(seqs.missing, seq)
+		st.totalReorders++
+		st.totalDdrops--
+	case seq < seqs.first || seqs.last-seq > seqDupWindow:
+		// never seen that far back, the sensor or device restarted
+		st.seqs[key] = &seqState{first: seq, last: seq}
+	case dup != 0 && seq == dup+1:
+		// dups counting up one by one are a restart which went back only a little
+		st.totalDups--
+		st.seqs[key] = &seqState{first: dup, last: seq}
+	default:
+		st.totalDups++
+		seqs.dup = seq
+	}
+}
+
+func (st *gRPCStats) setState(state string) {
+	st.Lock()
+	defer st.Unlock()
+	st.state = state
+	switch state {
+	case stateConnecting:
+		if st.dialed {
+			st.reconnects++
+		}
+		st.dialed = true
+	case stateSubscribed:
+		st.startTime = time.Now()
+		// a new subscription numbers its messages from scratch
+		st.seqs = nil
+	}
+}
+
+func (st *gRPCStats) setLogin(result string) {
+	st.Lock()
+	defer st.Unlock()
+	st.loginResult = result
+}
+
+// inBytes accounts payload of sources not going through the gRPC stats handler
+func (st *gRPCStats) inBytes(n int) {
+	st.Lock()
+	defer st.Unlock()
+	st.totalInPayloadLength += uint64(n)
+	st.totalInPayloadWireLength += uint64(n)
+}
+
+func (st *gRPCStats) decodeErr() {
+	st.Lock()
+	defer st.Unlock()
+	st.decodeErrors++
+}
+
+func (st *gRPCStats) status(d *device) rest.DeviceStatus {
+	cfg := d.config()
+	st.Lock()
+	defer st.Unlock()
+	return rest.DeviceStatus{
+		UUID:             cfg.UUID,
+		Host:             cfg.Host,
+		State:            st.state,
+		LoginResult:      st.loginResult,
+		SubscribedAt:     st.startTime,
+		LastMessage:      st.lastMsg,
+		Messages:         st.totalIn,
+		KVs:              st.totalKV,
+		PayloadBytes:     st.totalInPayloadLength,
+		PayloadWireBytes: st.totalInPayloadWireLength,
+		HeaderWireBytes:  st.totalInHeaderWireLength,
+		DecodeErrors:     st.decodeErrors,
+		Reconnects:       st.reconnects,
+		Gaps:             st.totalGaps,
+		Reorders:         st.totalReorders,
+		Dups:             st.totalDups,
+		Drops:            st.totalDdrops,
+	}
+}
+
+//DeviceStats collector side view of a device, reported as self monitoring points
+type DeviceStats struct {
+	Host      string
+	Msgs      uint64
+	KVs       uint64
+	Gaps      uint64
+	Reorders  uint64
+	Dups      uint64
+	Drops     uint64
+	Timestamp time.Time
+}
+
+// points are the self monitoring points of a device
+func (st *gRPCStats) points(host string) []dataPoint {
+	now := time.Now()
+	points := []dataPoint{st.point(host, now)}
+	for _, l := range st.latencyStats() {
+		points = append(points, &LatencyStats{
+			Host:      host,
+			Latency:   l,
+			Timestamp: now,
+		})
+	}
+	return points
+}
+
+func (st *gRPCStats) point(host string, now time.Time) *DeviceStats {
+	st.Lock()
+	defer st.Unlock()
+	return &DeviceStats{
+		Host:      host,
+		Msgs:      st.totalIn,
+		KVs:       st.totalKV,
+		Gaps:      st.totalGaps,
+		Reorders:  st.totalReorders,
+		Dups:      st.totalDups,
+		Drops:     st.totalDdrops,
+		Timestamp: now,
+	}
+}
+
+//Record turns stats into a record for outputs
+func (ds *DeviceStats) Record() Record {
+	tags := map[string]string{
+		"host": ds.Host,
+	}
+	fields := map[string]interface{}{
+		"msgs":     int64(ds.Msgs),
+		"kvs":      int64(ds.KVs),
+		"gaps":     int64(ds.Gaps),
+		"reorders": int64(ds.Reorders),
+		"dups":     int64(ds.Dups),
+		"drops":    int64(ds.Drops),
+	}
+	return Record{
+		Measurement: metricDevice,
+		Tags:        tags,
+		Fields:      fields,
+		Timestamp:   ds.Timestamp,
+	}
 }
 
 type statsHandler struct {
@@ -36,7 +271,9 @@ func (h *statsHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) conte
 func (h *statsHandler) HandleConn(ctx context.Context, s stats.ConnStats) {
 	switch s.(type) {
 	case *stats.ConnBegin:
+		h.cfg.Stats.setState(stateConnected)
 	case *stats.ConnEnd:
+		h.cfg.Stats.setState(stateDisconnected)
 	default:
 	}
 }
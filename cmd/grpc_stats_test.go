@@ -0,0 +1,61 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestReceived(t *testing.T) {
+	// 0 starts a new subscription
+	tests := []struct {
+		name     string
+		seqs     []uint64
+		gaps     uint64
+		drops    uint64
+		reorders uint64
+		dups     uint64
+	}{
+		{"in order", []uint64{1, 2, 3, 4}, 0, 0, 0, 0},
+		{"gap", []uint64{1, 2, 5, 6}, 1, 2, 0, 0},
+		{"reorder", []uint64{1, 3, 2, 4}, 1, 0, 1, 0},
+		{"late after a gap", []uint64{1, 4, 5, 3}, 1, 1, 1, 0},
+		{"dup", []uint64{1, 2, 2, 3}, 0, 0, 0, 1},
+		{"dups of one message", []uint64{1, 2, 3, 3, 3, 4}, 0, 0, 0, 2},
+		{"late message is a dup once it showed up", []uint64{1, 3, 2, 2}, 1, 0, 1, 1},
+		{"restart back to the first message", []uint64{1, 2, 3, 4, 5, 1, 2, 3}, 0, 0, 0, 0},
+		{"restart before the first message", []uint64{100, 101, 102, 1, 2}, 0, 0, 0, 0},
+		{"restart from far away", []uint64{5000, 5001, 10, 11, 13}, 1, 1, 0, 0},
+		{"new subscription", []uint64{1, 2, 3, 0, 1, 2}, 0, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var st gRPCStats
+			key := seqKey{systemID: "r1", componentID: 1, path: "/interfaces/"}
+			for _, seq := range tt.seqs {
+				if seq == 0 {
+					st.setState(stateSubscribed)
+					continue
+				}
+				st.received(key, seq, 1)
+			}
+			if st.totalGaps != tt.gaps || st.totalDdrops != tt.drops || st.totalReorders != tt.reorders || st.totalDups != tt.dups {
+				t.Errorf("gaps %d drops %d reorders %d dups %d, want %d %d %d %d",
+					st.totalGaps, st.totalDdrops, st.totalReorders, st.totalDups, tt.gaps, tt.drops, tt.reorders, tt.dups)
+			}
+		})
+	}
+}
+
+func TestReceivedStreams(t *testing.T) {
+	var st gRPCStats
+	fpc0 := seqKey{systemID: "r1", componentID: 0, path: "/interfaces/"}
+	fpc1 := seqKey{systemID: "r1", componentID: 1, path: "/interfaces/"}
+	for _, seq := range []uint64{1, 2, 3} {
+		st.received(fpc0, seq, 1)
+		st.received(fpc1, seq+10, 1)
+	}
+	// unnumbered messages are counted but not tracked
+	st.received(seqKey{}, 0, 2)
+	if st.totalGaps != 0 || st.totalDups != 0 || st.totalIn != 7 || st.totalKV != 8 {
+		t.Errorf("gaps %d dups %d messages %d KVs %d", st.totalGaps, st.totalDups, st.totalIn, st.totalKV)
+	}
+}
@@ -1,73 +1,122 @@
 package main
 
 import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
 	"github.com/influxdata/influxdb/client/v2"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 )
 
 type influxDB struct {
-	Addr       string
-	Pass       string
-	User       string
-	Precision  string
-	DBName     string
-	MetricName string
-	BatchSize  int
-	client     client.Client
-	bp         client.BatchPoints
-	dataCh     chan ifxPoint
+	Addr      string
+	Pass      string
+	User      string
+	Precision string
+	DBName    string
+	writeURL  string
+	client    *http.Client
 }
 
-type ifxPoint interface {
-	AddPoint(inf *influxDB)
+func newInfluxOutput(cfg *viper.Viper) (Output, error) {
+	ifx := &influxDB{
+		Addr:      cfg.GetString("address"),
+		User:      cfg.GetString("user"),
+		Pass:      cfg.GetString("pass"),
+		Precision: cfg.GetString("precision"),
+		DBName:    cfg.GetString("dbname"),
+	}
+	if ifx.Pass == "" {
+		ifx.Pass = cfg.GetString("password")
+	}
+	u, err := url.Parse(ifx.Addr)
+	if err != nil {
+		logErrEvent(ifxLogTopic, eventClientErr, err)
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		err = fmt.Errorf("unsupported protocol scheme: %s", u.Scheme)
+		logErrEvent(ifxLogTopic, eventClientErr, err)
+		return nil, err
+	}
+	// the influx client hides the status code, which tells a refused batch from a failed write
+	u.Path = strings.TrimRight(u.Path, "/") + "/write"
+	q := url.Values{}
+	q.Set("db", ifx.DBName)
+	q.Set("precision", ifx.Precision)
+	u.RawQuery = q.Encode()
+	ifx.writeURL = u.String()
+	ifx.client = &http.Client{}
+	return ifx, nil
 }
 
-func (ifx *influxDB) sendToInflux() {
-	defer ifx.client.Close()
-	for d := range ifx.dataCh {
-		d.AddPoint(ifx)
-		if len(ifx.bp.Points()) > ifx.BatchSize {
-			// Write the batch
-			err := ifx.client.Write(ifx.bp)
-			if err != nil {
-				logErrEvent(ifxLogTopic, eventWrPointsErr, err)
-			} else {
-				logrus.WithFields(logrus.Fields{
-					"topic":  ifxLogTopic,
-					"event":  "wrote",
-					"points": len(ifx.bp.Points()),
-				}).Info("wrote points into influx db")
-				// Recreate a new point batch as we do not want to keep writing same points
-				ifx.bp, err = client.NewBatchPoints(client.BatchPointsConfig{
-					Database:  ifx.DBName,
-					Precision: ifx.Precision,
-				})
-				if err != nil {
-					logErrEvent(ifxLogTopic, eventBathcPtrErr, err)
-				}
-			}
+//Write sends records as a single batch
+func (ifx *influxDB) Write(recs []Record) error {
+	bp, err := client.NewBatchPoints(client.BatchPointsConfig{
+		Database:  ifx.DBName,
+		Precision: ifx.Precision,
+	})
+	if err != nil {
+		logErrEvent(ifxLogTopic, eventBathcPtrErr, err)
+		return err
+	}
+	for _, r := range recs {
+		pt, err := client.NewPoint(r.Measurement, r.Tags, r.Fields, r.Timestamp)
+		if err != nil {
+			logErrEvent(ifxLogTopic, eventBathcPointrErr, err)
+			continue
 		}
+		bp.AddPoint(pt)
 	}
+	var body bytes.Buffer
+	for _, pt := range bp.Points() {
+		body.WriteString(pt.PrecisionString(ifx.Precision))
+		body.WriteByte('\n')
+	}
+	err = ifx.post(&body, len(bp.Points()))
+	if err != nil {
+		logErrEvent(ifxLogTopic, eventWrPointsErr, err)
+		return err
+	}
+	logrus.WithFields(logrus.Fields{
+		"topic":  ifxLogTopic,
+		"event":  "wrote",
+		"points": len(bp.Points()),
+	}).Info("wrote points into influx db")
+	return nil
 }
 
-func (ifx *influxDB) NewClientAndPoints() error {
-	var err error
-	ifx.client, err = client.NewHTTPClient(client.HTTPConfig{
-		Addr:     ifx.Addr,
-		Username: ifx.User,
-		Password: ifx.Pass,
-	})
+// post writes line protocol, 1.x errors come in the same body as the 2.x ones
+// so they tell refused and partial writes apart the same way
+func (ifx *influxDB) post(body io.Reader, lines int) error {
+	req, err := http.NewRequest(http.MethodPost, ifx.writeURL, body)
 	if err != nil {
-		logErrEvent(ifxLogTopic, eventClientErr, err)
 		return err
 	}
-	ifx.bp, err = client.NewBatchPoints(client.BatchPointsConfig{
-		Database:  ifx.DBName,
-		Precision: ifx.Precision,
-	})
+	req.Header.Set("User-Agent", "InfluxDBClient")
+	if ifx.User != "" {
+		req.SetBasicAuth(ifx.User, ifx.Pass)
+	}
+	resp, err := ifx.client.Do(req)
 	if err != nil {
-		logErrEvent(ifxLogTopic, eventBathcPtrErr, err)
 		return err
 	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+	msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	return influx2Error(resp.StatusCode, resp.Status, lines, msg)
+}
+
+//Close has nothing to release
+func (ifx *influxDB) Close() error {
 	return nil
 }
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/client/v2"
+	"github.com/spf13/viper"
+)
+
+const defaultInflux2Timeout = 30 * time.Second
+
+// influx2 precisions are named differently from the ones of the line protocol encoder
+var influx2Precisions = map[string]string{
+	"ns": "n",
+	"us": "u",
+	"ms": "ms",
+	"s":  "s",
+}
+
+// influxDB2 writes line protocol to the /api/v2/write endpoint of InfluxDB 2.x,
+// 3.x accepts the same endpoint with the bucket being a database
+type influxDB2 struct {
+	URL       string
+	Token     string
+	Org       string
+	Bucket    string
+	Precision string
+	Gzip      bool
+	writeURL  string
+	client    *http.Client
+}
+
+func newInflux2Output(cfg *viper.Viper) (Output, error) {
+	ifx := &influxDB2{
+		URL:       strings.TrimRight(cfg.GetString("url"), "/"),
+		Token:     cfg.GetString("token"),
+		Org:       cfg.GetString("org"),
+		Bucket:    cfg.GetString("bucket"),
+		Precision: cfg.GetString("precision"),
+		Gzip:      true,
+		client:    &http.Client{Timeout: defaultInflux2Timeout},
+	}
+	if ifx.URL == "" || ifx.Bucket == "" {
+		return nil, fmt.Errorf("influx2 output needs a url and a bucket")
+	}
+	if ifx.Precision == "" {
+		ifx.Precision = "ms"
+	}
+	if _, ok := influx2Precisions[ifx.Precision]; !ok {
+		return nil, fmt.Errorf("unknown precision %q, use one of ns, us, ms or s", ifx.Precision)
+	}
+	if cfg.IsSet("gzip") {
+		ifx.Gzip = cfg.GetBool("gzip")
+	}
+	if d := cfg.GetDuration("timeout"); d > 0 {
+		ifx.client.Timeout = d
+	}
+	q := url.Values{}
+	q.Set("org", ifx.Org)
+	q.Set("bucket", ifx.Bucket)
+	q.Set("precision", ifx.Precision)
+	ifx.writeURL = ifx.URL + "/api/v2/write?" + q.Encode()
+	return ifx, nil
+}
+
+//Write sends records as one line protocol body
+func (ifx *influxDB2) Write(recs []Record) error {
+	var body bytes.Buffer
+	var w io.Writer = &body
+	var zw *gzip.Writer
+	if ifx.Gzip {
+		zw = gzip.NewWriter(&body)
+		w = zw
+	}
+	lines := 0
+	for _, r := range recs {
+		pt, err := client.NewPoint(r.Measurement, r.Tags, r.Fields, r.Timestamp)
+		if err != nil {
+			logErrEvent(influx2Topic, eventBathcPointrErr, err)
+			continue
+		}
+		io.WriteString(w, pt.PrecisionString(influx2Precisions[ifx.Precision]))
+		io.WriteString(w, "\n")
+		lines++
+	}
+	if zw != nil {
+		err := zw.Close()
+		if err != nil {
+			return err
+		}
+	}
+	if lines == 0 {
+		return nil
+	}
+	req, err := http.NewRequest(http.MethodPost, ifx.writeURL, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if ifx.Gzip {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	if ifx.Token != "" {
+		req.Header.Set("Authorization", "Token "+ifx.Token)
+	}
+	resp, err := ifx.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		io.Copy(ioutil.Discard, resp.Body)
+		logInfoEvent(influx2Topic, "wrote", fmt.Sprintf("bucket: %s lines: %d", ifx.Bucket, lines))
+		return nil
+	}
+	msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	return influx2Error(resp.StatusCode, resp.Status, lines, msg)
+}
+
+// influx2ErrBody covers both 2.x errors and the 3.x per line details
+type influx2ErrBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Error   string `json:"error"`
+	Data    []struct {
+		LineNumber   int    `json:"line_number"`
+		ErrorMessage string `json:"error_message"`
+	} `json:"data"`
+}
+
+// influx2Error tells a rejected batch from a partially written one,
+// on a partial write the other lines are stored so sending the batch again would duplicate them.
+// Client errors other than throttling are permanent, sending the same data again fails the same way.
+func influx2Error(code int, status string, lines int, msg []byte) error {
+	var eb influx2ErrBody
+	if json.Unmarshal(msg, &eb) != nil {
+		return influx2Permanent(code, fmt.Errorf("server returned %s: %s", status, strings.TrimSpace(string(msg))))
+	}
+	text := eb.Message
+	if text == "" {
+		text = eb.Error
+	}
+	partial := strings.Contains(text, "partial write")
+	if !partial {
+		return influx2Permanent(code, fmt.Errorf("server returned %s: %s", status, text))
+	}
+	for _, d := range eb.Data {
+		logErrEvent(influx2Topic, influx2LineErrEv, fmt.Errorf("line %d: %s", d.LineNumber, d.ErrorMessage))
+	}
+	// 2.x only says how many made it in the message
+	if len(eb.Data) == 0 {
+		return permanentErr{fmt.Errorf("%s, %d lines sent: %s", influx2PartialEv, lines, text)}
+	}
+	return permanentErr{fmt.Errorf("%s, %d lines sent, %d rejected: %s", influx2PartialEv, lines, len(eb.Data), text)}
+}
+
+func influx2Permanent(code int, err error) error {
+	if code/100 == 4 && code != http.StatusTooManyRequests {
+		return permanentErr{err}
+	}
+	return err
+}
+
+//Close has nothing to release
+func (ifx *influxDB2) Close() error {
+	return nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// influx2Server answers every write with status and body after checking what came in
+type influx2Server struct {
+	t      *testing.T
+	status int
+	body   string
+	query  string
+	lines  string
+}
+
+func (s *influx2Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/api/v2/write" || r.Header.Get("Authorization") != "Token secret" {
+		s.t.Errorf("unexpected request %s %v", r.URL, r.Header)
+	}
+	s.query = r.URL.RawQuery
+	body := r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		zr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			s.t.Fatal(err)
+		}
+		body = zr
+	}
+	data, _ := ioutil.ReadAll(body)
+	s.lines = string(data)
+	w.WriteHeader(s.status)
+	w.Write([]byte(s.body))
+}
+
+func newTestInflux2(t *testing.T, url string, gzip bool) *influxDB2 {
+	cfg := viper.New()
+	cfg.Set("url", url+"/")
+	cfg.Set("token", "secret")
+	cfg.Set("org", "lab")
+	cfg.Set("bucket", "telemetry")
+	cfg.Set("gzip", gzip)
+	out, err := newInflux2Output(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out.(*influxDB2)
+}
+
+func TestInflux2Write(t *testing.T) {
+	recs := []Record{
+		{Measurement: metricPhyIf, Tags: map[string]string{"host": "r1", "name": "xe-0/0/0"}, Fields: map[string]interface{}{"mtu": int64(1514)}, Timestamp: time.Unix(1, 0)},
+		{Measurement: metricPhyIf, Tags: map[string]string{"host": "r1", "name": "xe-0/0/1"}, Fields: map[string]interface{}{}, Timestamp: time.Unix(1, 0)},
+	}
+	for _, gz := range []bool{true, false} {
+		s := &influx2Server{t: t, status: http.StatusNoContent}
+		srv := httptest.NewServer(s)
+		err := newTestInflux2(t, srv.URL, gz).Write(recs)
+		srv.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the record without fields can't be a point and is left out
+		if s.lines != "phy_interface,host=r1,name=xe-0/0/0 mtu=1514i 1000\n" {
+			t.Errorf("gzip %v: got %q", gz, s.lines)
+		}
+		if s.query != "bucket=telemetry&org=lab&precision=ms" {
+			t.Errorf("gzip %v: query %q", gz, s.query)
+		}
+	}
+}
+
+func TestInflux2Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		permanent bool
+		msg       string
+	}{
+		{"server error", http.StatusServiceUnavailable, `{"code":"unavailable","message":"try later"}`, false, "try later"},
+		{"throttled", http.StatusTooManyRequests, `not json`, false, "not json"},
+		{"bad request", http.StatusBadRequest, `{"code":"invalid","message":"unable to parse"}`, true, "unable to parse"},
+		{"v2 partial write", http.StatusBadRequest, `{"code":"invalid","message":"partial write: field type conflict"}`, true, influx2PartialEv + ", 1 lines sent"},
+		{"v3 partial write", http.StatusBadRequest,
+			`{"error":"partial write of line protocol occurred","data":[{"line_number":1,"error_message":"bad tag"}]}`,
+			true, influx2PartialEv + ", 1 lines sent, 1 rejected"},
+	}
+	recs := []Record{{Measurement: metricPhyIf, Tags: map[string]string{"host": "r1"}, Fields: map[string]interface{}{"mtu": int64(1514)}, Timestamp: time.Unix(1, 0)}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(&influx2Server{t: t, status: tt.status, body: tt.body})
+			defer srv.Close()
+			err := newTestInflux2(t, srv.URL, false).Write(recs)
+			if err == nil || !strings.Contains(err.Error(), tt.msg) {
+				t.Fatalf("got %v", err)
+			}
+			if _, ok := err.(permanentErr); ok != tt.permanent {
+				t.Errorf("error %v is permanent %v, want %v", err, ok, tt.permanent)
+			}
+		})
+	}
+}
+
+func TestInflux2Config(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("url", "http://localhost:8086")
+	if _, err := newInflux2Output(cfg); err == nil {
+		t.Error("no bucket accepted")
+	}
+	cfg.Set("bucket", "telemetry")
+	cfg.Set("precision", "h")
+	if _, err := newInflux2Output(cfg); err == nil {
+		t.Error("unknown precision accepted")
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+func TestInfluxWrite(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		err       bool
+		permanent bool
+	}{
+		{"written", http.StatusNoContent, ``, false, false},
+		{"unavailable", http.StatusServiceUnavailable, `{"error":"timeout"}`, true, false},
+		{"no database", http.StatusNotFound, `{"error":"database not found: \"telemetry\""}`, true, true},
+		{"bad line", http.StatusBadRequest, `{"error":"unable to parse 'phy_interface mtu=': missing field value"}`, true, true},
+		{"partial write", http.StatusBadRequest, `{"error":"partial write: field type conflict dropped=1"}`, true, true},
+	}
+	recs := []Record{{Measurement: metricPhyIf, Tags: map[string]string{"host": "r1"}, Fields: map[string]interface{}{"mtu": int64(1514)}, Timestamp: time.Unix(1, 0)}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lines, query, user string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				data, _ := ioutil.ReadAll(r.Body)
+				lines, query = string(data), r.URL.Path+"?"+r.URL.RawQuery
+				user, _, _ = r.BasicAuth()
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+			cfg := viper.New()
+			cfg.Set("address", srv.URL)
+			cfg.Set("user", "sticoll")
+			cfg.Set("dbname", "telemetry")
+			cfg.Set("precision", "s")
+			out, err := newInfluxOutput(cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = out.Write(recs)
+			if (err != nil) != tt.err {
+				t.Fatalf("error %v", err)
+			}
+			if _, ok := err.(permanentErr); ok != tt.permanent {
+				t.Errorf("error %v is permanent %v, want %v", err, ok, tt.permanent)
+			}
+			if lines != "phy_interface,host=r1 mtu=1514i 1\n" || query != "/write?db=telemetry&precision=s" || user != "sticoll" {
+				t.Errorf("sent %q to %s as %q", lines, query, user)
+			}
+		})
+	}
+}
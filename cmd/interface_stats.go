@@ -1,400 +1,537 @@
 package main
 
 import (
-	"encoding/json"
-	"errors"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 	"time"
 
 	na_pb "sticoll/telemetry"
-
-	"github.com/influxdata/influxdb/client/v2"
 )
 
 const (
-	linecardPhyIf   = "/junos/system/linecard/interface/"
-	linecardLogicIF = "/junos/system/linecard/interface/logical/usage/"
-	interfaces      = "/interfaces/"
+	linecardPhyIf     = "/junos/system/linecard/interface/"
+	linecardLogicalIf = "/junos/system/linecard/interface/logical/usage/"
+	interfaces        = "/interfaces/"
+	ifEventRemoved    = "removed"
 )
 
 type interfaceStats struct {
-	pifsMap       map[string]PhyInterfaceStats
-	pif           PhyInterfaceStats
-	qStats        QueueStats
-	prefixFound   bool
-	countersFound bool
-	ifxPointCh    chan ifxPoint
+	// entities of sensors decoded by a schema and walks in progress by component and sensor path,
+	// every line card walks its own interfaces and sends its own EOM
+	entities map[entityKey]*schemaEntity
+	walks    map[seqKey]*schemaWalk
+	pointCh  chan dataPoint
+	// names of queues of the device, nil when we do not know the device
+	classes *forwardingClasses
+	// sensor paths the source is able to stream, entities do not wait for
+	// required sensors which are not among them. nil is every sensor.
+	sensors map[string]bool
 }
 
-func newinterfaceStats(ifxPointCh chan ifxPoint) *interfaceStats {
+func newinterfaceStats(pointCh chan dataPoint, classes *forwardingClasses) *interfaceStats {
 	var i interfaceStats
-	i.pifsMap = make(map[string]PhyInterfaceStats)
-	i.qStats.queues = make([]OutQueue, 8)
-	i.ifxPointCh = ifxPointCh
+	i.entities = make(map[entityKey]*schemaEntity)
+	i.walks = make(map[seqKey]*schemaWalk)
+	i.pointCh = pointCh
+	i.classes = classes
 	return &i
 }
 
-//QueueStats ss
-type QueueStats struct {
-	notOnlyBufSize bool
-	queues         []OutQueue
-	IfName         string
-	Host           string
+//InterfaceEvent is something that happened to an interface as a whole,
+//sinks keeping live state should drop the interface once it is removed
+type InterfaceEvent struct {
+	Host      string
+	Name      string
+	Event     string
+	Timestamp time.Time
 }
 
-//OutQueue ss
-type OutQueue struct {
-	OutQueue            int64
-	Bytes               int64
-	PeakBufferOccupancy int64
-	AvgBufferOccupancy  int64
-	RedDropPkts         int64
-	AllocatedBufferSize int64
-	Pkts                int64
-	RedDropBytes        int64
+//Record turns stats into a record for outputs
+func (e *InterfaceEvent) Record() Record {
+	tags := map[string]string{
+		"name": e.Name,
+		"host": e.Host,
+	}
+	fields := map[string]interface{}{
+		"event": e.Event,
+	}
+	return Record{
+		Measurement: metricIfEvent,
+		Tags:        tags,
+		Fields:      fields,
+		Timestamp:   e.Timestamp,
+	}
 }
 
-//PhyInterfaceStats sa
-type PhyInterfaceStats struct {
-	Prefix                   string
-	Host                     string
-	Name                     string
-	InitTime                 int64
-	ParentAeName             string
-	OperStatus               string
-	CarrierTransitions       int64
-	LastChange               int64
-	HighSpeed                int64
-	CountersOutOctets        int64
-	CountersOutUnicastPkts   int64
-	CountersOutMulticastPkts int64
-	CountersOutBroadcastPkts int64
-	CountersInOctets         int64
-	CountersInUnicastPkts    int64
-	CountersInMulticastPkts  int64
-	CountersInBroadcastPkts  int64
-	CountersInErrors         int64
-	StateMtu                 int64
-	StateEnabled             bool
-	StateType                string
-	StateCountersOutOctets   string
-	StateAdminStatus         string
-	StateLastChange          int64
-	StateCountersInOctets    string
-	StateCountersLastClear   string
-	StateCountersInPkts      string
-	StateCountersOutPkts     string
-	OperationalState         string
-	StateName                string
-	StateDescription         string
-	StateIfindex             int64
-	StateOperStatus          string
-	linePhyIf                bool
-	ifState                  bool
-	Timestamp                time.Time
+// schemaWalk collects entities of one sensor path between two End-of-Message markers.
+// A single interface can be split across packets so nothing is sent
+// until the device says it is done with the whole walk, if it ever says so.
+type schemaWalk struct {
+	sensor *sensorSchema
+	// entity KVs currently belong to, its scope and what follows it in the prefix,
+	// all may carry over to the next packet
+	name      string
+	scope     string
+	scopeTags map[string]string
+	rest      string
+	// the prefix is not about an entity of the sensor, its KVs are of no interest
+	skip bool
+	// entities by group as list elements may belong to another group than the sensor
+	entities map[entityKey]*schemaEntity
+	// list elements sent as points of their own
+	elements map[elementKey]*schemaEntity
+	// devices without EOM support never send one, their walks end with every packet
+	eomSeen bool
 }
 
-//AddPoint add data to influx
-func (pif *PhyInterfaceStats) AddPoint(inf *influxDB) {
-	tags := map[string]string{
-		"name":        pif.Name,
-		"host":        pif.Host,
-		"desc":        pif.StateDescription,
-		"ae_name":     pif.ParentAeName,
-		"oper_state":  pif.OperStatus,
-		"admin_state": pif.StateAdminStatus,
+// schemaEntity is what the sensors of a group told us about one entity
+type schemaEntity struct {
+	Tags      map[string]string
+	Fields    map[string]interface{}
+	Timestamp time.Time
+	// sensor paths which reported the entity so far
+	seen map[string]bool
+}
+
+type entityKey struct {
+	group string
+	scope string
+	name  string
+}
+
+type elementKey struct {
+	list    *schemaList
+	scope   string
+	entity  string
+	element string
+}
+
+func newSchemaEntity() *schemaEntity {
+	return &schemaEntity{
+		Tags:   make(map[string]string),
+		Fields: make(map[string]interface{}),
+		seen:   make(map[string]bool),
 	}
-	fields := map[string]interface{}{
-		"carrier_transitions":         pif.CarrierTransitions,
-		"last_change":                 pif.LastChange,
-		"counters_out_octets":         pif.CountersOutOctets,
-		"counters_out_unicast_pkts":   pif.CountersOutUnicastPkts,
-		"counters_out_multicast_pkts": pif.CountersOutMulticastPkts,
-		"counters_out_broadcast_pkts": pif.CountersOutBroadcastPkts,
-		"counters_in_octets":          pif.CountersInOctets,
-		"counters_in_unicast_pkts":    pif.CountersInUnicastPkts,
-		"counters_in_multicast_pkts":  pif.CountersInMulticastPkts,
-		"counters_in_broadcast_pkts":  pif.CountersInBroadcastPkts,
-		"counters_in_errors":          pif.CountersInErrors,
-		"mtu":                         pif.StateMtu,
-	}
-	pt, err := client.NewPoint(metricPhyIf, tags, fields, time.Now())
-	if err != nil {
-		logErrEvent(ifxLogTopic, eventBathcPointrErr, err)
-		return
+}
+
+// merge applies a walk on top of what we know, an empty tag
+// e.g. the LAG of an interface which left it is gone
+func (e *schemaEntity) merge(w *schemaEntity, path string) {
+	for k, v := range w.Tags {
+		if v == "" {
+			delete(e.Tags, k)
+			continue
+		}
+		e.Tags[k] = v
 	}
-	inf.bp.AddPoint(pt)
+	for k, v := range w.Fields {
+		e.Fields[k] = v
+	}
+	if w.Timestamp.After(e.Timestamp) {
+		e.Timestamp = w.Timestamp
+	}
+	e.seen[path] = true
 }
 
-func parseNameFromPrefixVal(prefixVal string) (string, error) {
-	var rightFromEq, name string
-	rightFromEqSlice := strings.Split(prefixVal, "name='")
-	if len(rightFromEqSlice) > 1 {
-		rightFromEq = rightFromEqSlice[1]
-	} else {
-		return "", errors.New("did not find \"name='\" pattern to split str ")
-	}
-	rightFromBracket := strings.Split(rightFromEq, "']")
-	if len(rightFromBracket) > 0 {
-		name = rightFromBracket[0]
-	} else {
-		return "", errors.New("did not find \"']\" pattern to split str ")
-	}
-	return name, nil
+func (e *schemaEntity) complete(g *schemaGroup, sensors map[string]bool) bool {
+	for _, p := range g.Require {
+		if sensors != nil && !sensors[p] {
+			continue
+		}
+		if !e.seen[p] {
+			return false
+		}
+	}
+	return true
 }
 
-// fmt.Printf("Data: %08b \n", data[:4])
-func printAsJSON(i interface{}) {
-	b, err := json.MarshalIndent(i, "", "  ")
-	if err != nil {
-		log.Println(err)
+// record copies the entity, it keeps changing while outputs work on the point
+func (e *schemaEntity) record(measurement, hostname string) Record {
+	rec := Record{
+		Measurement: measurement,
+		Tags:        make(map[string]string, len(e.Tags)+4),
+		Fields:      make(map[string]interface{}, len(e.Fields)),
+		Timestamp:   e.Timestamp,
 	}
-	fmt.Println(string(b))
+	for k, v := range e.Tags {
+		if v != "" {
+			rec.Tags[k] = v
+		}
+	}
+	for k, v := range e.Fields {
+		rec.Fields[k] = v
+	}
+	rec.Tags["host"] = hostname
+	return rec
 }
 
-func (s *interfaceStats) sendLinecardStats() {
-	if s.countersFound {
-		extPhyif, ok := s.pifsMap[s.pif.Name]
-		if ok {
-			if s.qStats.notOnlyBufSize {
-				fmt.Println("QSTAAAATS")
-				printAsJSON(s.qStats)
-				// fmt.Println("QSTAAAATS", s.qStats.IfName, s.qStats.queues[0].Pkts, s.qStats.queues[1].Pkts, s.qStats.queues[2].Pkts, s.qStats.queues[3].Pkts, s.qStats.queues[4].Pkts)
+// point carries the fields a walk w reported at the time of the walk and the tags of the whole entity.
+// Fields of other sensors were read at another time, stamping them again
+// with this one would make rates computed from them swing.
+func (e *schemaEntity) point(g *schemaGroup, name, hostname string, w *schemaEntity) *entityPoint {
+	rec := w.record(g.Measurement, hostname)
+	for k, v := range e.Tags {
+		rec.Tags[k] = v
+	}
+	rec.Tags[g.EntityTag] = name
+	if g.Split != "" {
+		if i := strings.LastIndex(name, g.Split); i > 0 {
+			rec.Tags[g.SplitTags[0]] = name[:i]
+			rec.Tags[g.SplitTags[1]] = name[i+len(g.Split):]
+		}
+	}
+	return &entityPoint{rec: rec}
+}
+
+// entityPoint is an entity or a list element decoded by a schema
+type entityPoint struct {
+	rec Record
+}
+
+//Record turns stats into a record for outputs
+func (p *entityPoint) Record() Record {
+	return p.rec
+}
+
+func (s *interfaceStats) walk(sn *sensorSchema, ocData *na_pb.OpenConfigData) *schemaWalk {
+	k := seqKey{
+		systemID:       ocData.SystemId,
+		componentID:    ocData.ComponentId,
+		subComponentID: ocData.SubComponentId,
+		path:           sn.Path,
+	}
+	w, ok := s.walks[k]
+	if !ok {
+		w = &schemaWalk{sensor: sn}
+		w.reset()
+		s.walks[k] = w
+	}
+	return w
+}
+
+// decode runs a sensor through its schema, paths without one are ignored
+func (s *interfaceStats) decode(dataType string, ocData *na_pb.OpenConfigData, hostname string) {
+	sn, ok := schemas.sensors[dataType]
+	if !ok {
+		return
+	}
+	w := s.walk(sn, ocData)
+	// collector time is the time of decoding, not whenever outputs get to the point
+	timestamp := pointTime(time.Unix(0, int64(ocData.Timestamp)*1000000))
+	// keys before a prefix are reported once per message, not once per key
+	dropped := 0
+	for _, kv := range ocData.Kv {
+		if kv.Key == "__prefix__" {
+			w.prefix(kv.GetStrValue())
+			continue
+		}
+		if w.name == "" {
+			if !w.skip && !strings.HasPrefix(kv.Key, "__") {
+				dropped++
 			}
-			extPhyif.InitTime = s.pif.InitTime
-			extPhyif.ParentAeName = s.pif.ParentAeName
-			extPhyif.OperStatus = s.pif.OperStatus
-			extPhyif.CarrierTransitions = s.pif.CarrierTransitions
-			extPhyif.LastChange = s.pif.LastChange
-			extPhyif.HighSpeed = s.pif.HighSpeed
-			extPhyif.CountersOutOctets = s.pif.CountersOutOctets
-			extPhyif.CountersOutUnicastPkts = s.pif.CountersOutUnicastPkts
-			extPhyif.CountersOutMulticastPkts = s.pif.CountersOutMulticastPkts
-			extPhyif.CountersOutBroadcastPkts = s.pif.CountersOutBroadcastPkts
-			extPhyif.CountersInOctets = s.pif.CountersInOctets
-			extPhyif.CountersInUnicastPkts = s.pif.CountersInUnicastPkts
-			extPhyif.CountersInMulticastPkts = s.pif.CountersInMulticastPkts
-			extPhyif.CountersInBroadcastPkts = s.pif.CountersInBroadcastPkts
-			extPhyif.CountersInErrors = s.pif.CountersInErrors
-			extPhyif.Timestamp = s.pif.Timestamp
-			extPhyif.linePhyIf = true
-			// if extPhyif.linePhyIf {
-			if extPhyif.linePhyIf && extPhyif.ifState {
-				s.ifxPointCh <- &extPhyif
-				// if extPhyif.Name == "ge-0/0/0" {
-				// 	printAsJSON(extPhyif)
-				// 	fmt.Println("Got new data for linecard ", extPhyif.Name, extPhyif.CountersInUnicastPkts)
-				// 	s.ifxPointCh <- &extPhyif
-				// }
+			continue
+		}
+		key := w.rest + kv.Key
+		e := w.entity(sn.group, w.name)
+		e.Timestamp = timestamp
+		if !sn.apply(e, key, kv) {
+			w.list(e, key, kv, timestamp)
+		}
+	}
+	if dropped > 0 {
+		logErrEvent(ifsLogTopic, eventNoPrefixErr, fmt.Errorf("%s from %s: %d keys", dataType, hostname, dropped))
+	}
+	s.eom(w, ocData, hostname)
+}
+
+// list puts a KV of a list element where the list says, false if no list knows the key
+func (w *schemaWalk) list(e *schemaEntity, key string, kv *na_pb.KeyValue, timestamp time.Time) bool {
+	for _, l := range w.sensor.Lists {
+		element, leaf, ok := l.match(key)
+		if !ok {
+			continue
+		}
+		switch {
+		case l.Measurement != "":
+			k := elementKey{list: l, scope: w.scope, entity: w.name, element: element}
+			el, ok := w.elements[k]
+			if !ok {
+				el = newSchemaEntity()
+				for t, v := range w.scopeTags {
+					el.Tags[t] = v
+				}
+				w.elements[k] = el
 			}
-			s.pifsMap[s.pif.Name] = extPhyif
-		} else {
-			s.pif.linePhyIf = true
-			s.pifsMap[s.pif.Name] = s.pif
+			el.Timestamp = timestamp
+			return applyKV(l.Tags, l.Fields, el, leaf, "", kv)
+		case l.group != nil:
+			el := w.entity(l.group, w.name+l.Join+element)
+			el.Timestamp = timestamp
+			return applyKV(l.Tags, l.Fields, el, leaf, "", kv)
+		case l.Sum:
+			return sumKV(l.Fields, e, leaf, element+"_", kv)
+		default:
+			return applyKV(l.Tags, l.Fields, e, leaf, element+"_", kv)
 		}
-		s.countersFound = false
 	}
-	s.pif = *new(PhyInterfaceStats)
-	s.qStats.queues = make([]OutQueue, 8)
+	return false
 }
 
-func (s *interfaceStats) prefixMet(prefixVal string) {
-	if !s.prefixFound {
-		name, err := parseNameFromPrefixVal(prefixVal)
-		if err != nil {
+// prefix switches the walk to the entity named in a __prefix__ value
+func (w *schemaWalk) prefix(prefixVal string) {
+	name, rest, err := prefixSplit(prefixVal, w.sensor.Entity)
+	_, w.skip = err.(errNoEntity)
+	if err != nil {
+		if !w.skip {
 			logErrEvent(ifsLogTopic, eventParseFromPrxErr, err)
-			return
 		}
-		s.pif.Name = name
+		w.name = ""
+		return
 	}
-	if s.prefixFound {
-		s.sendLinecardStats()
-		name, err := parseNameFromPrefixVal(prefixVal)
-		if err != nil {
-			logErrEvent(ifsLogTopic, eventParseFromPrxErr, err)
-			return
+	scope, scopeTags := w.sensor.scope(prefixVal)
+	w.name = name
+	w.scope = scope
+	w.scopeTags = scopeTags
+	w.rest = rest
+}
+
+func (w *schemaWalk) entity(g *schemaGroup, name string) *schemaEntity {
+	k := entityKey{group: g.Name, scope: w.scope, name: name}
+	e, ok := w.entities[k]
+	if !ok {
+		e = newSchemaEntity()
+		for t, v := range w.scopeTags {
+			e.Tags[t] = v
 		}
-		s.pif.Name = name
+		w.entities[k] = e
 	}
-	s.prefixFound = true
+	return e
 }
 
-func (s *interfaceStats) linecardPhyIfStats(ocData *na_pb.OpenConfigData, hostname string) {
-	var kvCount int
-	kvLen := len(ocData.Kv)
-	for _, kv := range ocData.Kv {
-		kvCount++
-		// fmt.Printf("key is %s and value is %s\n", kv.Key, kv.Value)
-		switch kv.Key {
-		case "init_time":
-			s.pif.InitTime = kv.GetIntValue()
-		case "parent_ae_name":
-			s.pif.ParentAeName = kv.GetStrValue()
-		case "oper-status":
-			s.pif.OperStatus = kv.GetStrValue()
-		case "carrier-transitions":
-			s.pif.CarrierTransitions = kv.GetIntValue()
-		case "last-change":
-			s.pif.LastChange = kv.GetIntValue()
-		case "high-speed":
-			s.pif.HighSpeed = kv.GetIntValue()
-		case "counters/out-octets":
-			s.countersFound = true
-			s.pif.CountersOutOctets = kv.GetIntValue()
-		case "counters/out-unicast-pkts":
-			s.pif.CountersOutUnicastPkts = kv.GetIntValue()
-		case "counters/out-multicast-pkts":
-			s.pif.CountersOutMulticastPkts = kv.GetIntValue()
-		case "counters/out-broadcast-pkts":
-			s.pif.CountersOutBroadcastPkts = kv.GetIntValue()
-		case "counters/in-octets":
-			s.pif.CountersInOctets = kv.GetIntValue()
-		case "counters/in-unicast-pkts":
-			s.pif.CountersInUnicastPkts = kv.GetIntValue()
-		case "counters/in-multicast-pkts":
-			s.pif.CountersInMulticastPkts = kv.GetIntValue()
-		case "counters/in-broadcast-pkts":
-			s.pif.CountersInBroadcastPkts = kv.GetIntValue()
-		case "counters/in-errors":
-			s.pif.CountersInErrors = kv.GetIntValue()
-		case "__prefix__":
-			s.pif.Timestamp = time.Unix(0, int64(ocData.Timestamp)*1000000)
-			s.prefixMet(kv.GetStrValue())
-		default:
-			s.missingPrefixChk(ocData, kv.Key)
-			if strings.HasPrefix(kv.Key, "out-queue") {
-				s.linecardPhyIfQueue(kv)
+// eom ends a walk if the packet carries an End-of-Message marker.
+// Until a sensor sent one every packet is a walk of its own like it used to be,
+// an entity split across packets is put together again when merged.
+func (s *interfaceStats) eom(w *schemaWalk, ocData *na_pb.OpenConfigData, hostname string) {
+	switch {
+	case len(ocData.Eom) > 0:
+		w.eomSeen = true
+		w.name = ""
+	case w.eomSeen:
+		return
+	}
+	s.flush(w, hostname)
+	w.reset()
+}
+
+func (w *schemaWalk) reset() {
+	w.entities = make(map[entityKey]*schemaEntity)
+	w.elements = make(map[elementKey]*schemaEntity)
+}
+
+// flush merges every entity of a finished walk into its group
+// and sends the ones all required sensors have reported
+func (s *interfaceStats) flush(w *schemaWalk, hostname string) {
+	for key, e := range w.entities {
+		g := schemas.groups[key.group]
+		ext, ok := s.entities[key]
+		if !ok {
+			ext = newSchemaEntity()
+			s.entities[key] = ext
+		}
+		s.events(g, key.name, ext, e, hostname)
+		ext.merge(e, w.sensor.Path)
+		// a point without fields is refused by most outputs,
+		// tags the walk changed show up with the next fields
+		if ext.complete(g, s.sensors) && len(e.Fields) > 0 {
+			s.pointCh <- ext.point(g, key.name, hostname, e)
+		}
+	}
+	// elements only have what a single walk told about them
+	for key, el := range w.elements {
+		if len(el.Fields) == 0 {
+			continue
+		}
+		rec := el.record(key.list.Measurement, hostname)
+		rec.Tags[w.sensor.group.EntityTag] = key.entity
+		rec.Tags[key.list.Tag] = key.element
+		for k, v := range key.list.StaticTags {
+			rec.Tags[k] = v
+		}
+		if key.list.ClassTag != "" {
+			if fc := s.classes.name(key.element); fc != "" {
+				rec.Tags[key.list.ClassTag] = fc
 			}
 		}
-		// This was once needed for something but should be perhaps removed
-		if kvCount == kvLen {
-			s.pif.Host = hostname
-			s.sendLinecardStats()
+		s.pointCh <- &entityPoint{rec: rec}
+	}
+}
+
+// events sends changes of watched tags and fields, the first value is not a change
+func (s *interfaceStats) events(g *schemaGroup, name string, ext, e *schemaEntity, hostname string) {
+	for _, watched := range g.Watch {
+		was, known := ext.value(watched)
+		now, reported := e.value(watched)
+		if !known || !reported || was == now {
+			continue
 		}
+		s.pointCh <- ext.event(g, name, hostname, e.Timestamp, map[string]interface{}{
+			"event": watched,
+			"from":  was,
+			"to":    now,
+		})
 	}
 }
 
-func (s *interfaceStats) linecardPhyIfQueue(kv *na_pb.KeyValue) {
-	s.qStats.IfName = s.pif.Name
-	qNum, metric, err := parseQueuKey(kv.Key)
-	if err == nil {
-		switch metric {
-		case "allocated-buffer-size":
-			s.qStats.queues[qNum].AllocatedBufferSize = kv.GetIntValue()
-		case "pkts":
-			s.qStats.notOnlyBufSize = true
-			s.qStats.queues[qNum].Pkts = kv.GetIntValue()
-		case "bytes":
-			s.qStats.notOnlyBufSize = true
-			s.qStats.queues[qNum].Bytes = kv.GetIntValue()
-		case "avg-buffer-occupancy":
-			s.qStats.notOnlyBufSize = true
-			s.qStats.queues[qNum].AvgBufferOccupancy = kv.GetIntValue()
-		case "peak-buffer-occupancy":
-			s.qStats.notOnlyBufSize = true
-			s.qStats.queues[qNum].PeakBufferOccupancy = kv.GetIntValue()
-		case "red-drop-pkts":
-			s.qStats.notOnlyBufSize = true
-			s.qStats.queues[qNum].RedDropPkts = kv.GetIntValue()
-		case "red-drop-bytes":
-			s.qStats.notOnlyBufSize = true
-			s.qStats.queues[qNum].RedDropPkts = kv.GetIntValue()
-		default:
-			log.Println("Unknown metric", metric)
+func (e *schemaEntity) value(name string) (string, bool) {
+	if v, ok := e.Tags[name]; ok {
+		return v, true
+	}
+	if v, ok := e.Fields[name]; ok {
+		return fmt.Sprint(v), true
+	}
+	return "", false
+}
+
+// event is a point about an entity in the events measurement of its group,
+// tags say which entity it is, watched ones would only repeat the event
+func (e *schemaEntity) event(g *schemaGroup, name, hostname string, timestamp time.Time, fields map[string]interface{}) *entityPoint {
+	rec := Record{
+		Measurement: g.Events,
+		Tags:        map[string]string{g.EntityTag: name, "host": hostname},
+		Fields:      fields,
+		Timestamp:   timestamp,
+	}
+	for k, v := range e.Tags {
+		if _, ok := rec.Tags[k]; !ok {
+			rec.Tags[k] = v
 		}
-	} else {
-		logErrEvent(ifsLogTopic, eventParseQueuKeyErr, err)
 	}
+	for _, watched := range g.Watch {
+		delete(rec.Tags, watched)
+	}
+	return &entityPoint{rec: rec}
 }
 
-func parseQueuKey(key string) (int, string, error) {
-	var (
-		queueNuber int
-		metric     string
-		err        error
-	)
-	// out-queue [queue-number=0]/pkts &{121494907}
-	//remove everything before =
-	woEq := strings.Split(key, "=")
-	if len(woEq) > 1 {
-		queueNuber, err = strconv.Atoi((strings.Split(woEq[1], "]")[0]))
+// deletes retires interfaces the device told us are gone.
+// Deleting the interface itself removes it everywhere, a deleted leaf is dropped
+// from the entity when the schema maps it, anything else just stops updating.
+func (s *interfaceStats) deletes(dataType string, ocData *na_pb.OpenConfigData, hostname string) {
+	sn := schemas.sensors[dataType]
+	attr := defaultEntityAttr
+	if sn != nil {
+		attr = sn.Entity
+	}
+	for _, del := range ocData.Delete {
+		name, leaf, err := prefixSplit(del.Path, attr)
 		if err != nil {
-			return 0, "", err
+			if _, ok := err.(errNoEntity); !ok {
+				logErrEvent(ifsLogTopic, eventParseFromPrxErr, err)
+			}
+			continue
+		}
+		leaf = strings.TrimRight(leaf, "/")
+		timestamp := pointTime(time.Unix(0, int64(ocData.Timestamp)*1000000))
+		// entities with events of their own are not interfaces
+		if sn != nil && sn.group.Events != "" {
+			if leaf == "" {
+				s.removeEntity(sn, del.Path, name, hostname, timestamp)
+			} else {
+				s.forget(sn, del.Path, name, leaf)
+			}
+			continue
+		}
+		if leaf == "" {
+			s.removeIf(name, hostname, timestamp)
+			continue
+		}
+		if sn != nil {
+			s.forget(sn, del.Path, name, leaf)
 		}
-		metric = strings.Split(woEq[1], "/")[1]
 	}
-	return queueNuber, metric, nil
 }
 
-func (s *interfaceStats) missingPrefixChk(ocData *na_pb.OpenConfigData, key string) {
-	if !strings.HasPrefix(key, "__") {
-		if !s.prefixFound && !strings.HasPrefix(key, "/") {
-			fmt.Printf("Missing prefix for sensor: %s\n", ocData.Path)
-		}
+// forget drops a deleted leaf from an entity, the next point goes without it
+func (s *interfaceStats) forget(sn *sensorSchema, path, name, leaf string) {
+	scope, _ := sn.scope(path)
+	e, ok := s.entities[entityKey{group: sn.group.Name, scope: scope, name: name}]
+	if ok {
+		sn.forget(e, leaf)
 	}
 }
 
-func (s *interfaceStats) interfaceState(ocData *na_pb.OpenConfigData, hostname string) {
-	for _, kv := range ocData.Kv {
-		switch kv.Key {
-		case "name":
-			s.pif.Name = kv.GetStrValue()
-		case "state/type":
-			s.pif.StateType = kv.GetStrValue()
-		case "state/mtu":
-			s.pif.StateMtu = kv.GetIntValue()
-		case "state/name":
-			s.pif.StateName = kv.GetStrValue()
-		case "state/description":
-			s.pif.StateDescription = kv.GetStrValue()
-		case "state/enabled":
-			s.pif.StateEnabled = kv.GetBoolValue()
-		case "state/ifindex":
-			s.pif.StateIfindex = kv.GetIntValue()
-		case "state/admin-status":
-			s.pif.StateAdminStatus = kv.GetStrValue()
-		case "state/oper-status":
-			s.pif.OperStatus = kv.GetStrValue()
-		case "state/last-change":
-			s.pif.StateLastChange = kv.GetIntValue()
-		case "__prefix__":
-			extPhyif, ok := s.pifsMap[s.pif.Name]
-			if ok {
-				extPhyif.Name = s.pif.Name
-				extPhyif.StateType = s.pif.StateType
-				extPhyif.StateMtu = s.pif.StateMtu
-				extPhyif.StateName = s.pif.StateName
-				extPhyif.StateDescription = s.pif.StateDescription
-				extPhyif.StateEnabled = s.pif.StateEnabled
-				extPhyif.StateIfindex = s.pif.StateIfindex
-				extPhyif.StateAdminStatus = s.pif.StateAdminStatus
-				extPhyif.OperStatus = s.pif.OperStatus
-				extPhyif.StateLastChange = s.pif.StateLastChange
-				extPhyif.Host = hostname
-				// extPhyif.Timestamp = time.Unix(0, int64(ocData.Timestamp)*1000000)
-				extPhyif.ifState = true
-				s.pifsMap[s.pif.Name] = extPhyif
-				if extPhyif.linePhyIf && extPhyif.ifState {
-					s.ifxPointCh <- &extPhyif
-					// if extPhyif.Name == "ge-0/0/0" {
-					// 	fmt.Println("State")
-					// 	printAsJSON(extPhyif)
-					// 	// fmt.Println("Got new state data ", extPhyif.Name, extPhyif.CountersInUnicastPkts)
-					// 	// s.ifxPointCh <- &extPhyif
-
-					// }
-				}
-			} else {
-				extPhyif.ifState = true
-				s.pifsMap[s.pif.Name] = s.pif
+// removeEntity forgets an entity the device deleted and says so in the group events
+func (s *interfaceStats) removeEntity(sn *sensorSchema, path, name, hostname string, timestamp time.Time) {
+	scope, _ := sn.scope(path)
+	key := entityKey{group: sn.group.Name, scope: scope, name: name}
+	e, ok := s.entities[key]
+	if !ok {
+		return
+	}
+	delete(s.entities, key)
+	for _, w := range s.walks {
+		delete(w.entities, key)
+	}
+	s.pointCh <- e.event(sn.group, name, hostname, timestamp, map[string]interface{}{"event": ifEventRemoved})
+}
+
+func (s *interfaceStats) removeIf(name, hostname string, timestamp time.Time) {
+	known := false
+	for key := range s.entities {
+		if key.name == name {
+			known = true
+			delete(s.entities, key)
+		}
+	}
+	for _, w := range s.walks {
+		for key := range w.entities {
+			if key.name == name {
+				known = true
+				delete(w.entities, key)
 			}
-			s.pif = *new(PhyInterfaceStats)
-			s.prefixFound = true
-		default:
+		}
+		for key := range w.elements {
+			if key.entity == name {
+				delete(w.elements, key)
+			}
+		}
+		if w.name == name {
+			w.name = ""
+		}
+	}
+	if !known {
+		return
+	}
+	s.pointCh <- &InterfaceEvent{
+		Host:      hostname,
+		Name:      name,
+		Event:     ifEventRemoved,
+		Timestamp: timestamp,
+	}
+}
 
+// kvInt reads an integer no matter how a vendor decided to encode it,
+// gNMI JSON_IETF for example sends 64 bit counters as strings
+func kvInt(kv *na_pb.KeyValue) int64 {
+	switch v := kv.Value.(type) {
+	case *na_pb.KeyValue_IntValue:
+		return v.IntValue
+	case *na_pb.KeyValue_UintValue:
+		return int64(v.UintValue)
+	case *na_pb.KeyValue_SintValue:
+		return v.SintValue
+	case *na_pb.KeyValue_DoubleValue:
+		return int64(v.DoubleValue)
+	case *na_pb.KeyValue_StrValue:
+		i, err := strconv.ParseInt(v.StrValue, 10, 64)
+		if err != nil {
+			u, err := strconv.ParseUint(v.StrValue, 10, 64)
+			if err != nil {
+				return 0
+			}
+			return int64(u)
 		}
+		return i
 	}
+	return 0
 }
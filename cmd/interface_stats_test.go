@@ -0,0 +1,196 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	na_pb "sticoll/telemetry"
+)
+
+func kvStr(key, value string) *na_pb.KeyValue {
+	return &na_pb.KeyValue{Key: key, Value: &na_pb.KeyValue_StrValue{StrValue: value}}
+}
+
+func kvUint(key string, value uint64) *na_pb.KeyValue {
+	return &na_pb.KeyValue{Key: key, Value: &na_pb.KeyValue_UintValue{UintValue: value}}
+}
+
+// packet is a message of a sensor, at is ms after the start of the test
+type packet struct {
+	path      string
+	component uint32
+	at        uint64
+	kvs       []*na_pb.KeyValue
+	deletes   []string
+	eom       bool
+}
+
+const (
+	xe0    = "/interfaces/interface[name='xe-0/0/0']/"
+	master = "/network-instances/network-instance[instance-name='master']/neighbor[neighbor-address='10.0.0.2']/"
+	blue   = "/network-instances/network-instance[instance-name='blue']/neighbor[neighbor-address='10.0.0.2']/"
+)
+
+func TestDecode(t *testing.T) {
+	useSchema(t, testSchema)
+	tests := []struct {
+		name    string
+		packets []packet
+		// measurement, tags, fields and ms after the start, in any order
+		want []string
+	}{
+		{
+			name: "waits for required sensors",
+			packets: []packet{
+				{path: "/lc/", at: 1, kvs: []*na_pb.KeyValue{kvStr("__prefix__", xe0), kvStr("parent_ae_name", "ae0"), kvUint("counters/in-octets", 100)}, eom: true},
+				{path: "/if/", at: 2, kvs: []*na_pb.KeyValue{kvStr("__prefix__", xe0), kvStr("state/oper-status", "UP"), kvUint("state/mtu", 1514)}, eom: true},
+				{path: "/lc/", at: 3, kvs: []*na_pb.KeyValue{kvStr("__prefix__", xe0), kvUint("counters/in-octets", 200)}, eom: true},
+			},
+			// fields of other sensors are not sent again under a newer time
+			want: []string{
+				"phy_interface map[ae_name:ae0 host:r1 name:xe-0/0/0 oper_state:UP] map[mtu:1514] @2",
+				"phy_interface map[ae_name:ae0 host:r1 name:xe-0/0/0 oper_state:UP] map[in_octets:200] @3",
+			},
+		},
+		{
+			name: "walk spans packets once EOM is seen",
+			packets: []packet{
+				{path: "/bgp/", at: 1, kvs: []*na_pb.KeyValue{kvStr("__prefix__", master), kvStr("state/session-state", "ESTABLISHED"), kvUint("state/peer-as", 65001)}, eom: true},
+				{path: "/bgp/", at: 2, kvs: []*na_pb.KeyValue{kvStr("__prefix__", master), kvUint("state/peer-as", 65002)}},
+				{path: "/bgp/", at: 3, kvs: []*na_pb.KeyValue{kvStr("state/session-state", "IDLE")}, eom: true},
+			},
+			want: []string{
+				"bgp map[host:r1 neighbor:10.0.0.2 session_state:ESTABLISHED vrf:master] map[peer_as:65001] @1",
+				"bgp map[host:r1 neighbor:10.0.0.2 session_state:IDLE vrf:master] map[peer_as:65002] @3",
+				"bgp_events map[host:r1 neighbor:10.0.0.2 vrf:master] map[event:session_state from:ESTABLISHED to:IDLE] @3",
+			},
+		},
+		{
+			name: "components walk on their own",
+			packets: []packet{
+				{path: "/bgp/", component: 0, at: 1, kvs: []*na_pb.KeyValue{kvStr("__prefix__", master), kvUint("state/peer-as", 65001)}, eom: true},
+				{path: "/bgp/", component: 1, at: 1, kvs: []*na_pb.KeyValue{kvStr("__prefix__", blue), kvUint("state/peer-as", 65002)}, eom: true},
+				{path: "/bgp/", component: 0, at: 2, kvs: []*na_pb.KeyValue{kvStr("__prefix__", master), kvUint("state/peer-as", 65003)}},
+				// neither ends the walk of component 0 nor switches its entity
+				{path: "/bgp/", component: 1, at: 3, kvs: []*na_pb.KeyValue{kvStr("__prefix__", blue), kvUint("state/peer-as", 65004)}, eom: true},
+				{path: "/bgp/", component: 0, at: 4, kvs: []*na_pb.KeyValue{kvUint("state/peer-as", 65005)}, eom: true},
+			},
+			want: []string{
+				"bgp map[host:r1 neighbor:10.0.0.2 vrf:master] map[peer_as:65001] @1",
+				"bgp map[host:r1 neighbor:10.0.0.2 vrf:blue] map[peer_as:65002] @1",
+				"bgp map[host:r1 neighbor:10.0.0.2 vrf:blue] map[peer_as:65004] @3",
+				"bgp map[host:r1 neighbor:10.0.0.2 vrf:master] map[peer_as:65005] @4",
+			},
+		},
+		{
+			name: "every packet is a walk without EOM",
+			packets: []packet{
+				{path: "/bgp/", at: 1, kvs: []*na_pb.KeyValue{kvStr("__prefix__", master), kvUint("state/peer-as", 65001)}},
+				{path: "/bgp/", at: 2, kvs: []*na_pb.KeyValue{kvStr("__prefix__", master), kvUint("state/peer-as", 65001)}},
+			},
+			want: []string{
+				"bgp map[host:r1 neighbor:10.0.0.2 vrf:master] map[peer_as:65001] @1",
+				"bgp map[host:r1 neighbor:10.0.0.2 vrf:master] map[peer_as:65001] @2",
+			},
+		},
+		{
+			name: "prefix tags keep entities apart",
+			packets: []packet{
+				{path: "/bgp/", at: 1, kvs: []*na_pb.KeyValue{kvStr("__prefix__", master), kvUint("state/peer-as", 65001), kvStr("__prefix__", blue), kvUint("state/peer-as", 65002)}},
+			},
+			want: []string{
+				"bgp map[host:r1 neighbor:10.0.0.2 vrf:master] map[peer_as:65001] @1",
+				"bgp map[host:r1 neighbor:10.0.0.2 vrf:blue] map[peer_as:65002] @1",
+			},
+		},
+		{
+			name: "prefix without the entity is skipped",
+			packets: []packet{
+				{path: "/bgp/", at: 1, kvs: []*na_pb.KeyValue{kvStr("__prefix__", "/network-instances/network-instance[instance-name='master']/"), kvUint("state/peer-as", 65001)}},
+			},
+		},
+		{
+			name: "list elements are points of their own",
+			packets: []packet{
+				{path: "/lc/", at: 1, kvs: []*na_pb.KeyValue{kvStr("__prefix__", xe0), kvUint("out-queue [queue-number=0]/pkts", 10), kvUint("out-queue [queue-number=3]/pkts", 30)}, eom: true},
+			},
+			want: []string{
+				"queue_stats map[host:r1 name:xe-0/0/0 queue:0] map[pkts:10] @1",
+				"queue_stats map[host:r1 name:xe-0/0/0 queue:3] map[pkts:30] @1",
+			},
+		},
+		{
+			name: "deleted leaf leaves the entity",
+			packets: []packet{
+				{path: "/lc/", at: 1, kvs: []*na_pb.KeyValue{kvStr("__prefix__", xe0), kvStr("parent_ae_name", "ae0"), kvUint("counters/in-octets", 100)}, eom: true},
+				{path: "/if/", at: 2, kvs: []*na_pb.KeyValue{kvStr("__prefix__", xe0), kvUint("state/mtu", 1514)}, eom: true},
+				{path: "/lc/", at: 3, deletes: []string{xe0 + "parent_ae_name"}, eom: true},
+				{path: "/lc/", at: 4, kvs: []*na_pb.KeyValue{kvStr("__prefix__", xe0), kvUint("counters/in-octets", 200)}, eom: true},
+			},
+			want: []string{
+				"phy_interface map[ae_name:ae0 host:r1 name:xe-0/0/0] map[mtu:1514] @2",
+				"phy_interface map[host:r1 name:xe-0/0/0] map[in_octets:200] @4",
+			},
+		},
+		{
+			name: "deleted interface is removed once",
+			packets: []packet{
+				{path: "/if/", at: 1, kvs: []*na_pb.KeyValue{kvStr("__prefix__", xe0), kvUint("state/mtu", 1514)}, eom: true},
+				{path: "/if/", at: 2, deletes: []string{xe0}, eom: true},
+				{path: "/if/", at: 3, deletes: []string{xe0}, eom: true},
+			},
+			want: []string{
+				"interface_event map[host:r1 name:xe-0/0/0] map[event:removed] @2",
+			},
+		},
+		{
+			name: "deleted neighbor is an event of its group",
+			packets: []packet{
+				{path: "/bgp/", at: 1, kvs: []*na_pb.KeyValue{kvStr("__prefix__", master), kvStr("state/session-state", "ESTABLISHED")}},
+				{path: "/bgp/", at: 2, deletes: []string{master}},
+			},
+			want: []string{
+				"bgp_events map[host:r1 neighbor:10.0.0.2 vrf:master] map[event:removed] @2",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now().Truncate(time.Millisecond)
+			ch := make(chan dataPoint, 100)
+			s := newinterfaceStats(ch, nil)
+			for _, p := range tt.packets {
+				ocData := &na_pb.OpenConfigData{
+					ComponentId: p.component,
+					Timestamp:   uint64(start.UnixNano()/int64(time.Millisecond)) + p.at,
+					Kv:          p.kvs,
+				}
+				for _, del := range p.deletes {
+					ocData.Delete = append(ocData.Delete, &na_pb.Delete{Path: del})
+				}
+				if p.eom {
+					ocData.Eom = []*na_pb.Eom{{}}
+				}
+				s.deletes(p.path, ocData, "r1")
+				s.decode(p.path, ocData, "r1")
+			}
+			var got []string
+			for len(ch) > 0 {
+				r := (<-ch).Record()
+				got = append(got, fmt.Sprintf("%s %v %v @%d", r.Measurement, r.Tags, r.Fields, r.Timestamp.Sub(start)/time.Millisecond))
+			}
+			sort.Strings(got)
+			want := append([]string{}, tt.want...)
+			sort.Strings(want)
+			if len(got) == 0 && len(want) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got\n%v\nwant\n%v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"text/template"
+	"time"
+
+	rec_pb "sticoll/record"
+
+	"github.com/Shopify/sarama"
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/viper"
+)
+
+const (
+	kafkaJSON          = "json"
+	kafkaProtobuf      = "protobuf"
+	defaultKafkaTopic  = "sticoll.{{.Measurement}}"
+	defaultKafkaVer    = "1.0.0"
+	defaultKafkaClient = "sticoll"
+)
+
+// kafkaOutput publishes every record as a message, the key is host and interface
+// so all messages about an interface land in the same partition and stay in order
+type kafkaOutput struct {
+	Brokers  []string
+	Encoding string
+	topic    *template.Template
+	producer sarama.AsyncProducer
+	// topics rendered so far, there are only a few measurements,
+	// templates using tags depend on the record and are not cached
+	topicsMu  sync.Mutex
+	topics    map[string]string
+	cacheable bool
+	delivered uint64
+	failed    uint64
+	wg        sync.WaitGroup
+}
+
+// kafkaJSONRecord is what the JSON encoding looks like
+type kafkaJSONRecord struct {
+	Measurement string                 `json:"measurement"`
+	Tags        map[string]string      `json:"tags"`
+	Fields      map[string]interface{} `json:"fields"`
+	Timestamp   time.Time              `json:"timestamp"`
+}
+
+func newKafkaOutput(cfg *viper.Viper) (Output, error) {
+	k := &kafkaOutput{
+		Brokers:  cfg.GetStringSlice("brokers"),
+		Encoding: cfg.GetString("encoding"),
+		topics:   make(map[string]string),
+	}
+	if len(k.Brokers) == 0 {
+		return nil, fmt.Errorf("kafka output needs brokers")
+	}
+	if k.Encoding == "" {
+		k.Encoding = kafkaJSON
+	}
+	if k.Encoding != kafkaJSON && k.Encoding != kafkaProtobuf {
+		return nil, fmt.Errorf("unknown encoding %q, use json or protobuf", k.Encoding)
+	}
+	topic := cfg.GetString("topic")
+	if topic == "" {
+		topic = defaultKafkaTopic
+	}
+	var err error
+	k.topic, err = template.New("topic").Parse(topic)
+	if err != nil {
+		return nil, fmt.Errorf("topic template: %v", err)
+	}
+	k.cacheable = !strings.Contains(topic, ".Tags")
+	sc, err := k.saramaCfg(cfg)
+	if err != nil {
+		return nil, err
+	}
+	k.producer, err = sarama.NewAsyncProducer(k.Brokers, sc)
+	if err != nil {
+		return nil, err
+	}
+	k.wg.Add(2)
+	go k.successes()
+	go k.errors()
+	return k, nil
+}
+
+func (k *kafkaOutput) saramaCfg(cfg *viper.Viper) (*sarama.Config, error) {
+	sc := sarama.NewConfig()
+	sc.ClientID = cfg.GetString("client_id")
+	if sc.ClientID == "" {
+		sc.ClientID = defaultKafkaClient
+	}
+	ver := cfg.GetString("version")
+	if ver == "" {
+		ver = defaultKafkaVer
+	}
+	var err error
+	sc.Version, err = sarama.ParseKafkaVersion(ver)
+	if err != nil {
+		return nil, err
+	}
+	switch cfg.GetString("compression") {
+	case "", "none":
+		sc.Producer.Compression = sarama.CompressionNone
+	case "gzip":
+		sc.Producer.Compression = sarama.CompressionGZIP
+	case "snappy":
+		sc.Producer.Compression = sarama.CompressionSnappy
+	case "lz4":
+		sc.Producer.Compression = sarama.CompressionLZ4
+	case "zstd":
+		sc.Producer.Compression = sarama.CompressionZSTD
+	default:
+		return nil, fmt.Errorf("unknown compression %q", cfg.GetString("compression"))
+	}
+	sc.Producer.Partitioner = sarama.NewHashPartitioner
+	sc.Producer.Return.Successes = true
+	sc.Producer.Return.Errors = true
+	sc.Producer.RequiredAcks = sarama.WaitForAll
+	// an idempotent producer does not duplicate messages when it retries,
+	// Kafka only allows it with a single request in flight per broker
+	if !cfg.IsSet("idempotent") || cfg.GetBool("idempotent") {
+		sc.Producer.Idempotent = true
+		sc.Net.MaxOpenRequests = 1
+	}
+	if cfg.IsSet("retries") {
+		sc.Producer.Retry.Max = cfg.GetInt("retries")
+	}
+	if d := cfg.GetDuration("flush_frequency"); d > 0 {
+		sc.Producer.Flush.Frequency = d
+	}
+	return sc, sc.Validate()
+}
+
+//Write queues messages with the producer, delivery is reported asynchronously
+func (k *kafkaOutput) Write(recs []Record) error {
+	for _, r := range recs {
+		topic, err := k.topicFor(r)
+		if err != nil {
+			atomic.AddUint64(&k.failed, 1)
+			logErrEvent(kafkaTopic, kafkaTopicErrEv, err)
+			continue
+		}
+		value, err := k.encode(r)
+		if err != nil {
+			atomic.AddUint64(&k.failed, 1)
+			logErrEvent(kafkaTopic, kafkaEncodeErrEv, err)
+			continue
+		}
+		k.producer.Input() <- &sarama.ProducerMessage{
+			Topic:     topic,
+			Key:       sarama.StringEncoder(r.Tags["host"] + "/" + r.Tags["name"]),
+			Value:     sarama.ByteEncoder(value),
+			Timestamp: r.Timestamp,
+		}
+	}
+	return nil
+}
+
+func (k *kafkaOutput) topicFor(r Record) (string, error) {
+	k.topicsMu.Lock()
+	defer k.topicsMu.Unlock()
+	if t, ok := k.topics[r.Measurement]; ok {
+		return t, nil
+	}
+	var b bytes.Buffer
+	err := k.topic.Execute(&b, r)
+	if err != nil {
+		return "", err
+	}
+	t := strings.TrimSpace(b.String())
+	if k.cacheable {
+		k.topics[r.Measurement] = t
+	}
+	return t, nil
+}
+
+func (k *kafkaOutput) encode(r Record) ([]byte, error) {
+	if k.Encoding == kafkaJSON {
+		return json.Marshal(kafkaJSONRecord(r))
+	}
+	pr := &rec_pb.Record{
+		Measurement: r.Measurement,
+		Tags:        r.Tags,
+		Fields:      make(map[string]*rec_pb.Value, len(r.Fields)),
+		Timestamp:   r.Timestamp.UnixNano(),
+	}
+	for name, v := range r.Fields {
+		pv := &rec_pb.Value{}
+		switch n := v.(type) {
+		case int64:
+			pv.Value = &rec_pb.Value_IntVal{IntVal: n}
+		case int:
+			pv.Value = &rec_pb.Value_IntVal{IntVal: int64(n)}
+		case uint64:
+			pv.Value = &rec_pb.Value_UintVal{UintVal: n}
+		case float64:
+			pv.Value = &rec_pb.Value_DoubleVal{DoubleVal: n}
+		case bool:
+			pv.Value = &rec_pb.Value_BoolVal{BoolVal: n}
+		case string:
+			pv.Value = &rec_pb.Value_StringVal{StringVal: n}
+		default:
+			pv.Value = &rec_pb.Value_StringVal{StringVal: fmt.Sprint(n)}
+		}
+		pr.Fields[name] = pv
+	}
+	return proto.Marshal(pr)
+}
+
+func (k *kafkaOutput) successes() {
+	defer k.wg.Done()
+	for range k.producer.Successes() {
+		atomic.AddUint64(&k.delivered, 1)
+	}
+}
+
+func (k *kafkaOutput) errors() {
+	defer k.wg.Done()
+	for err := range k.producer.Errors() {
+		atomic.AddUint64(&k.failed, 1)
+		logErrEvent(kafkaTopic, kafkaDeliveryErrEv, fmt.Errorf("topic %s: %v", err.Msg.Topic, err.Err))
+	}
+}
+
+func (k *kafkaOutput) stats() map[string]interface{} {
+	return map[string]interface{}{
+		"delivered": int64(atomic.LoadUint64(&k.delivered)),
+		"failed":    int64(atomic.LoadUint64(&k.failed)),
+	}
+}
+
+//Close waits for messages in flight to be delivered or to fail
+func (k *kafkaOutput) Close() error {
+	k.producer.AsyncClose()
+	k.wg.Wait()
+	return nil
+}
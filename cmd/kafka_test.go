@@ -0,0 +1,210 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	rec_pb "sticoll/record"
+
+	"github.com/Shopify/sarama"
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/viper"
+)
+
+// fakeProducer acks every message it gets, except for ones to topics in fail
+type fakeProducer struct {
+	input     chan *sarama.ProducerMessage
+	successes chan *sarama.ProducerMessage
+	errs      chan *sarama.ProducerError
+	fail      map[string]bool
+	sent      []*sarama.ProducerMessage
+}
+
+func newFakeProducer(fail ...string) *fakeProducer {
+	p := &fakeProducer{
+		input:     make(chan *sarama.ProducerMessage),
+		successes: make(chan *sarama.ProducerMessage),
+		errs:      make(chan *sarama.ProducerError),
+		fail:      make(map[string]bool),
+	}
+	for _, topic := range fail {
+		p.fail[topic] = true
+	}
+	go func() {
+		for msg := range p.input {
+			if p.fail[msg.Topic] {
+				p.errs <- &sarama.ProducerError{Msg: msg, Err: errors.New("no leader")}
+				continue
+			}
+			p.sent = append(p.sent, msg)
+			p.successes <- msg
+		}
+		close(p.successes)
+		close(p.errs)
+	}()
+	return p
+}
+
+func (p *fakeProducer) AsyncClose()                               { close(p.input) }
+func (p *fakeProducer) Close() error                              { p.AsyncClose(); return nil }
+func (p *fakeProducer) Input() chan<- *sarama.ProducerMessage     { return p.input }
+func (p *fakeProducer) Successes() <-chan *sarama.ProducerMessage { return p.successes }
+func (p *fakeProducer) Errors() <-chan *sarama.ProducerError      { return p.errs }
+
+func newTestKafka(t *testing.T, topic, encoding string, p sarama.AsyncProducer) *kafkaOutput {
+	tmpl, err := template.New("topic").Parse(topic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k := &kafkaOutput{
+		Encoding:  encoding,
+		topic:     tmpl,
+		producer:  p,
+		topics:    make(map[string]string),
+		cacheable: !strings.Contains(topic, ".Tags"),
+	}
+	k.wg.Add(2)
+	go k.successes()
+	go k.errors()
+	return k
+}
+
+var kafkaRec = Record{
+	Measurement: metricPhyIf,
+	Tags:        map[string]string{"host": "r1", "name": "xe-0/0/0"},
+	Fields: map[string]interface{}{
+		"in_octets": uint64(1 << 40), "mtu": int64(1514), "count": 3,
+		"load": 0.5, "up": true, "desc": "core", "speed": time.Second,
+	},
+	Timestamp: time.Unix(1600000000, 123),
+}
+
+func TestKafkaJSON(t *testing.T) {
+	k := &kafkaOutput{Encoding: kafkaJSON}
+	data, err := k.encode(kafkaRec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"fields":{"count":3,"desc":"core","in_octets":1099511627776,"load":0.5,"mtu":1514,"speed":1000000000,"up":true},` +
+		`"measurement":"phy_interface","tags":{"host":"r1","name":"xe-0/0/0"},"timestamp":"` +
+		kafkaRec.Timestamp.Format(time.RFC3339Nano) + `"}`
+	// maps marshal with sorted keys
+	again, _ := json.Marshal(got)
+	if string(again) != want {
+		t.Errorf("got\n%s\nwant\n%s", again, want)
+	}
+}
+
+func TestKafkaProtobuf(t *testing.T) {
+	k := &kafkaOutput{Encoding: kafkaProtobuf}
+	data, err := k.encode(kafkaRec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var pr rec_pb.Record
+	if err := proto.Unmarshal(data, &pr); err != nil {
+		t.Fatal(err)
+	}
+	if pr.Measurement != metricPhyIf || pr.Tags["host"] != "r1" || pr.Tags["name"] != "xe-0/0/0" || pr.Timestamp != kafkaRec.Timestamp.UnixNano() {
+		t.Errorf("got %v", pr)
+	}
+	f := pr.Fields
+	if f["in_octets"].GetUintVal() != 1<<40 || f["mtu"].GetIntVal() != 1514 || f["count"].GetIntVal() != 3 ||
+		f["load"].GetDoubleVal() != 0.5 || !f["up"].GetBoolVal() || f["desc"].GetStringVal() != "core" ||
+		f["speed"].GetStringVal() != "1s" || len(f) != len(kafkaRec.Fields) {
+		t.Errorf("fields %v", f)
+	}
+}
+
+func TestKafkaWrite(t *testing.T) {
+	p := newFakeProducer("sticoll.bad")
+	k := newTestKafka(t, "sticoll.{{.Tags.host}}", kafkaJSON, p)
+	recs := []Record{
+		kafkaRec,
+		{Measurement: metricQueue, Tags: map[string]string{"host": "r2", "name": "xe-0/0/1"}, Fields: map[string]interface{}{"pkts": int64(1)}},
+		{Measurement: metricQueue, Tags: map[string]string{"host": "bad", "name": "xe-0/0/1"}, Fields: map[string]interface{}{"pkts": int64(1)}},
+	}
+	if err := k.Write(recs); err != nil {
+		t.Fatal(err)
+	}
+	k.Close()
+	var got []string
+	for _, msg := range p.sent {
+		key, _ := msg.Key.Encode()
+		got = append(got, msg.Topic+" "+string(key))
+	}
+	sort.Strings(got)
+	if strings.Join(got, ",") != "sticoll.r1 r1/xe-0/0/0,sticoll.r2 r2/xe-0/0/1" {
+		t.Errorf("got %v", got)
+	}
+	// topics depending on tags are not cached per measurement
+	if len(k.topics) != 0 {
+		t.Errorf("cached %v", k.topics)
+	}
+	st := k.stats()
+	if st["delivered"] != int64(2) || st["failed"] != int64(1) {
+		t.Errorf("stats %v", st)
+	}
+}
+
+func TestKafkaTopic(t *testing.T) {
+	k := newTestKafka(t, defaultKafkaTopic, kafkaJSON, newFakeProducer())
+	defer k.Close()
+	topic, err := k.topicFor(kafkaRec)
+	if err != nil || topic != "sticoll.phy_interface" || k.topics[metricPhyIf] != topic {
+		t.Errorf("got %q %v, cache %v", topic, err, k.topics)
+	}
+}
+
+func TestKafkaConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		set  map[string]interface{}
+		err  string
+	}{
+		{"no brokers", nil, "needs brokers"},
+		{"encoding", map[string]interface{}{"brokers": "localhost:9092", "encoding": "avro"}, "unknown encoding"},
+		{"topic", map[string]interface{}{"brokers": "localhost:9092", "topic": "{{.Tags"}, "topic template"},
+		{"compression", map[string]interface{}{"brokers": "localhost:9092", "compression": "brotli"}, "unknown compression"},
+		{"version", map[string]interface{}{"brokers": "localhost:9092", "version": "one"}, "invalid version"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := viper.New()
+			for k, v := range tt.set {
+				cfg.Set(k, v)
+			}
+			_, err := newKafkaOutput(cfg)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("got %v, want %q", err, tt.err)
+			}
+		})
+	}
+
+	cfg := viper.New()
+	sc, err := (&kafkaOutput{}).saramaCfg(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// idempotence is on unless turned off and needs a single request in flight
+	if !sc.Producer.Idempotent || sc.Net.MaxOpenRequests != 1 || sc.ClientID != defaultKafkaClient ||
+		sc.Producer.Partitioner == nil || sc.Producer.RequiredAcks != sarama.WaitForAll {
+		t.Errorf("defaults %+v", sc.Producer)
+	}
+	cfg.Set("idempotent", false)
+	cfg.Set("compression", "zstd")
+	cfg.Set("version", "2.1.0")
+	sc, err = (&kafkaOutput{}).saramaCfg(cfg)
+	if err != nil || sc.Producer.Idempotent || sc.Producer.Compression != sarama.CompressionZSTD {
+		t.Errorf("got %v %+v", err, sc.Producer)
+	}
+}
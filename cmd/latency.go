@@ -0,0 +1,122 @@
+package main
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"sticoll/rest"
+)
+
+const (
+	// latency percentiles are over this many most recent messages of a sensor
+	latencyWindow     = 512
+	latencyUnknownSen = "unknown"
+)
+
+// latencyHist keeps the most recent latency samples of a sensor in ms
+// small enough to sort on every report
+type latencyHist struct {
+	samples []float64
+	next    int
+	count   uint64
+}
+
+func (h *latencyHist) add(ms float64) {
+	h.count++
+	if len(h.samples) < latencyWindow {
+		h.samples = append(h.samples, ms)
+		return
+	}
+	h.samples[h.next] = ms
+	h.next = (h.next + 1) % latencyWindow
+}
+
+func (h *latencyHist) stats(sensor string) rest.SensorLatency {
+	sorted := append([]float64{}, h.samples...)
+	sort.Float64s(sorted)
+	l := rest.SensorLatency{
+		Sensor: sensor,
+		Count:  h.count,
+	}
+	if len(sorted) == 0 {
+		return l
+	}
+	l.P50 = percentile(sorted, 0.50)
+	l.P95 = percentile(sorted, 0.95)
+	l.Max = sorted[len(sorted)-1]
+	// lag shows up in the tail, a shifted median means clocks are off.
+	// The limit is the one pointTime uses, a skewed device is one whose
+	// timestamps are replaced with ours.
+	l.Skew = math.Abs(l.P50) > float64(tsMaxSkew/time.Millisecond)
+	return l
+}
+
+func percentile(sorted []float64, p float64) float64 {
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i]
+}
+
+// sample compares a device timestamp with now, timestamps are in ms since epoch
+func (st *gRPCStats) sample(sensor string, deviceTs uint64) {
+	if deviceTs == 0 {
+		return
+	}
+	if sensor == "" {
+		sensor = latencyUnknownSen
+	}
+	ms := float64(time.Now().UnixNano()/int64(time.Millisecond)) - float64(deviceTs)
+	st.Lock()
+	defer st.Unlock()
+	if st.latency == nil {
+		st.latency = make(map[string]*latencyHist)
+	}
+	h, ok := st.latency[sensor]
+	if !ok {
+		h = &latencyHist{}
+		st.latency[sensor] = h
+	}
+	h.add(ms)
+}
+
+func (st *gRPCStats) latencyStats() []rest.SensorLatency {
+	st.Lock()
+	defer st.Unlock()
+	out := make([]rest.SensorLatency, 0, len(st.latency))
+	for sensor, h := range st.latency {
+		out = append(out, h.stats(sensor))
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Sensor < out[j].Sensor })
+	return out
+}
+
+//LatencyStats latency of a single sensor of a device, reported as self monitoring points
+type LatencyStats struct {
+	Host      string
+	Latency   rest.SensorLatency
+	Timestamp time.Time
+}
+
+//Record turns stats into a record for outputs
+func (ls *LatencyStats) Record() Record {
+	tags := map[string]string{
+		"host":   ls.Host,
+		"sensor": ls.Latency.Sensor,
+	}
+	fields := map[string]interface{}{
+		"count": int64(ls.Latency.Count),
+		"p50":   ls.Latency.P50,
+		"p95":   ls.Latency.P95,
+		"max":   ls.Latency.Max,
+		"skew":  ls.Latency.Skew,
+	}
+	return Record{
+		Measurement: metricLatency,
+		Tags:        tags,
+		Fields:      fields,
+		Timestamp:   ls.Timestamp,
+	}
+}
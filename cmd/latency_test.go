@@ -0,0 +1,94 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPercentile(t *testing.T) {
+	sorted := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	tests := []struct {
+		p    float64
+		want float64
+	}{
+		{0, 1},
+		{0.10, 1},
+		{0.50, 5},
+		{0.95, 10},
+		{1, 10},
+	}
+	for _, tt := range tests {
+		if got := percentile(sorted, tt.p); got != tt.want {
+			t.Errorf("p%v: got %v, want %v", tt.p*100, got, tt.want)
+		}
+	}
+	if got := percentile([]float64{42}, 0.95); got != 42 {
+		t.Errorf("single sample: got %v", got)
+	}
+}
+
+func TestLatencyHist(t *testing.T) {
+	var h latencyHist
+	if l := h.stats("/a/"); l.Count != 0 || l.P50 != 0 || l.Max != 0 {
+		t.Errorf("no samples: got %+v", l)
+	}
+	// the first window is pushed out by samples ten times bigger
+	for i := 1; i <= 2*latencyWindow; i++ {
+		ms := float64(i)
+		if i > latencyWindow {
+			ms *= 10
+		}
+		h.add(ms)
+	}
+	l := h.stats("/a/")
+	if l.Sensor != "/a/" || l.Count != 2*latencyWindow {
+		t.Errorf("got %+v", l)
+	}
+	if want := float64(10 * (latencyWindow + latencyWindow/2)); l.P50 != want {
+		t.Errorf("p50 %v, want %v", l.P50, want)
+	}
+	if want := float64(10 * 2 * latencyWindow); l.Max != want {
+		t.Errorf("max %v, want %v", l.Max, want)
+	}
+	if l.P95 < l.P50 || l.P95 > l.Max {
+		t.Errorf("p95 %v out of p50 %v max %v", l.P95, l.P50, l.Max)
+	}
+}
+
+func TestLatencySkew(t *testing.T) {
+	tests := []struct {
+		name string
+		ms   float64
+		skew bool
+	}{
+		{"late", float64(tsMaxSkew/time.Millisecond) / 2, false},
+		{"behind", float64(tsMaxSkew/time.Millisecond) * 2, true},
+		// a device clock running ahead shows up as negative latency
+		{"ahead", -float64(tsMaxSkew/time.Millisecond) * 2, true},
+	}
+	for _, tt := range tests {
+		var h latencyHist
+		for i := 0; i < 10; i++ {
+			h.add(tt.ms)
+		}
+		if l := h.stats("/a/"); l.Skew != tt.skew {
+			t.Errorf("%s: skew %v, want %v", tt.name, l.Skew, tt.skew)
+		}
+	}
+}
+
+func TestSample(t *testing.T) {
+	var st gRPCStats
+	now := uint64(time.Now().UnixNano() / int64(time.Millisecond))
+	st.sample("/b/", now-100)
+	st.sample("", now-100)
+	// devices not sending a timestamp have no latency
+	st.sample("/b/", 0)
+	ls := st.latencyStats()
+	if len(ls) != 2 || ls[0].Sensor != "/b/" || ls[1].Sensor != latencyUnknownSen {
+		t.Fatalf("got %+v", ls)
+	}
+	if ls[0].Count != 1 || ls[0].P50 < 100 || ls[0].P50 > 100+float64(time.Minute/time.Millisecond) {
+		t.Errorf("got %+v", ls[0])
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"strconv"
+	"time"
+)
+
+//LspStats traffic counters of an RSVP LSP
+type LspStats struct {
+	Host        string
+	Name        string
+	InstanceID  int64
+	CounterName string
+	Packets     int64
+	Bytes       int64
+	PacketRate  int64
+	ByteRate    int64
+	Timestamp   time.Time
+}
+
+//Record turns stats into a record for outputs
+func (lsp *LspStats) Record() Record {
+	tags := map[string]string{
+		"host":         lsp.Host,
+		"name":         lsp.Name,
+		"instance_id":  strconv.FormatInt(lsp.InstanceID, 10),
+		"counter_name": lsp.CounterName,
+	}
+	fields := map[string]interface{}{
+		"packets":     lsp.Packets,
+		"bytes":       lsp.Bytes,
+		"packet_rate": lsp.PacketRate,
+		"byte_rate":   lsp.ByteRate,
+	}
+	return Record{
+		Measurement: metricLsp,
+		Tags:        tags,
+		Fields:      fields,
+		Timestamp:   lsp.Timestamp,
+	}
+}
@@ -8,9 +8,13 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/rand"
 	"os"
+	"os/signal"
 	"runtime"
 	"strconv"
+	"sync"
+	"syscall"
 	"time"
 
 	auth_pb "sticoll/auth"
@@ -28,14 +32,53 @@ var (
 	debug bool
 )
 
+const (
+	dialTimeout   = 30 * time.Second
+	cancelTimeout = 5 * time.Second
+	// requests in flight get this long to finish on shutdown
+	apiStopTimeout = 5 * time.Second
+	cfgQueue       = 16
+	// reconnect backoff bounds, a session lasting longer than maxBackoff resets it
+	minBackoff = time.Second
+	maxBackoff = 5 * time.Minute
+)
+
 type device struct {
-	cfg        *rest.GRPCCfg
-	Stats      gRPCStats
-	ifxPointCh chan ifxPoint
-	Opts       []grpc.DialOption
+	// swapped by update along with addrs, whatever may run at the same time
+	// goes through config. A running session owns cfg as update stops it first.
+	cfgMu   sync.RWMutex
+	cfg     *rest.GRPCCfg
+	Stats   gRPCStats
+	pointCh chan dataPoint
+	Opts    []grpc.DialOption
+	// forwarding classes stay learned across restarts of the device
+	classes *forwardingClasses
+	// cancel stops a running device, done is closed once it has stopped
+	cancel context.CancelFunc
+	done   chan struct{}
+	// streams a device dialed in with, they end when the device is stopped
+	streamsMu sync.Mutex
+	streams   map[*dialoutStream]bool
+	// addresses the host resolved to when it was configured
+	addrs map[string]bool
+}
+
+func (d *device) config() *rest.GRPCCfg {
+	d.cfgMu.RLock()
+	defer d.cfgMu.RUnlock()
+	return d.cfg
+}
+
+// hasAddr is true if the host of the device resolved to addr
+func (d *device) hasAddr(addr string) bool {
+	d.cfgMu.RLock()
+	defer d.cfgMu.RUnlock()
+	return d.addrs[addr]
 }
 
 func init() {
+	// backoff jitter has to differ between runs
+	rand.Seed(time.Now().UnixNano())
 	nuCPU := runtime.NumCPU()
 	runtime.GOMAXPROCS(nuCPU)
 	logrus.WithFields(logrus.Fields{
@@ -85,7 +128,7 @@ func readDeviceCfgs(db *bolt.DB) ([]*rest.GRPCCfg, error) {
 	return gCfgs, nil
 }
 
-func appCfg() (*influxDB, *rest.HTTPCfg) {
+func appCfg() (*outputs, *rest.HTTPCfg) {
 	viper.SetConfigName("sticol")
 	viper.AddConfigPath(".")
 	viper.SetConfigType("toml")
@@ -94,28 +137,31 @@ func appCfg() (*influxDB, *rest.HTTPCfg) {
 	if err != nil {
 		logFatal("config", "open config failure", err)
 	}
-	// creating new influx structure and initialising
-	ifx := &influxDB{
-		Addr:      viper.GetString("influx.address"),
-		User:      viper.GetString("influx.user"),
-		Pass:      viper.GetString("influx.password"),
-		Precision: viper.GetString("influx.precision"),
-		DBName:    viper.GetString("influx.dbname"),
-		BatchSize: viper.GetInt("influx.batchsize"),
+	err = timestampCfg()
+	if err != nil {
+		logFatal(tsTopic, "timestamp config failure", err)
+	}
+	err = schemaCfg()
+	if err != nil {
+		logFatal(cfgErrTopic, "sensor schema failure", err)
 	}
-	err = ifx.NewClientAndPoints()
+	err = classesCfg()
 	if err != nil {
-		logFatal(ifxLogTopic, eventNewClientErr, err)
+		logFatal(cfgErrTopic, "forwarding classes failure", err)
 	}
-	ifx.dataCh = make(chan ifxPoint)
-	go ifx.sendToInflux()
+	// every configured output runs on its own, decoders feed all of them through one channel
+	outs, err := newOutputs()
+	if err != nil {
+		logFatal(outputTopic, outputCfgErrEv, err)
+	}
+	outs.start()
 
 	hcfg := &rest.HTTPCfg{
 		Port:   viper.GetString("http.port"),
 		UIPath: viper.GetString("http.uipath"),
 		Addr:   viper.GetString("http.address"),
 	}
-	return ifx, hcfg
+	return outs, hcfg
 	// cfg.Port = os.Getenv("PORT")
 	// cfg.Addr = os.Getenv("ADDRESS")
 	// if cfg.Port == "" {
@@ -124,6 +170,15 @@ func appCfg() (*influxDB, *rest.HTTPCfg) {
 	// return cfg
 }
 
+// monitorInterval is how often collector stats are reported as points
+func monitorInterval() time.Duration {
+	interval := viper.GetDuration("monitor.interval")
+	if interval <= 0 {
+		return defaultMonitorInterval
+	}
+	return interval
+}
+
 func main() {
 	logrus.SetFormatter(&logrus.TextFormatter{
 		DisableColors: false,
@@ -142,39 +197,100 @@ func main() {
 				logFatal("dbclose", "failure to close db file", err)
 			}
 		}()
-		ifx, hcfg := appCfg()
+		outs, hcfg := appCfg()
 		cfgs, err := readDeviceCfgs(db)
 		if err != nil {
 			logErrEvent(cfgErrTopic, cfgReadErrEv, err)
 		}
-		cfgCh := make(chan *rest.GRPCCfg)
+		// devices which push data to us are matched against the same device list
+		devices := newDeviceRegistry(outs.dataCh)
+		var devs []*device
+		for _, cfg := range cfgs {
+			devs = append(devs, devices.add(cfg))
+		}
+		// queued so API calls do not wait for a device being stopped by an earlier one
+		cfgCh := make(chan rest.CfgEvent, cfgQueue)
+		api := rest.NewHTTPSrv(hcfg, db, cfgCh, devices)
 		go func() {
-			err = rest.StartHTTPSrv(hcfg, db, &cfgs, cfgCh)
+			err := api.Start()
 			if err != nil {
 				logFatal("http", "failure to start http server", err)
 			}
 		}()
+		ctx, cancel := context.WithCancel(context.Background())
+		reportDone := make(chan struct{})
+		go func() {
+			defer close(reportDone)
+			devices.report(ctx, monitorInterval())
+		}()
+		outsReportDone := make(chan struct{})
+		go func() {
+			defer close(outsReportDone)
+			outs.report(ctx, monitorInterval())
+		}()
+		dialout := newDialoutSrv(devices)
+		if dialout.Port != "" {
+			go func() {
+				err := dialout.startDialout()
+				if err != nil {
+					logFatal(dialoutTopic, "failure to start dialout listener", err)
+				}
+			}()
+		}
+		udp := newUDPSrv(devices, outs.dataCh)
+		if udp.Port != "" {
+			go func() {
+				err := udp.startUDP()
+				if err != nil {
+					logFatal(udpTopic, "failure to start udp listener", err)
+				}
+			}()
+		}
 		// creating gorutines for each device and passing influx channel
 		// many device rutines pass data to a single influx rutine which writes data into the DB
-		// works on startup only
-		for _, cfg := range cfgs {
-			d := device{
-				cfg:        cfg,
-				ifxPointCh: ifx.dataCh,
-			}
-			go d.prepConAndSubscribe()
+		for _, d := range devs {
+			d.start()
 		}
-		// waiting for new devices and connecting to them
-		for newCfg := range cfgCh {
-			d := device{
-				cfg:        newCfg,
-				ifxPointCh: ifx.dataCh,
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+		// applying changes made through the API to running devices
+		for {
+			select {
+			case ev := <-cfgCh:
+				switch ev.Op {
+				case rest.CfgAdd:
+					devices.add(ev.Cfg).start()
+					logInfoEvent(grpcTopic, " new device", fmt.Sprintf("hostname: %s", ev.Cfg.Host))
+				case rest.CfgUpd:
+					devices.update(ev.Cfg)
+					logInfoEvent(grpcTopic, " device updated", fmt.Sprintf("hostname: %s", ev.Cfg.Host))
+				case rest.CfgDel:
+					devices.remove(ev.Cfg.UUID)
+					logInfoEvent(grpcTopic, " device removed", ev.Cfg.UUID.String())
+				}
+			case sig := <-sigCh:
+				logInfoEvent(shutdownTopic, "signal received", sig.String())
+				// no device may be added behind stopAll, changes still queued are
+				// stored and applied on the next start
+				apiCtx, apiCancel := context.WithTimeout(context.Background(), apiStopTimeout)
+				err := api.Stop(apiCtx)
+				apiCancel()
+				if err != nil {
+					logErrEvent(shutdownTopic, apiStopErrEv, err)
+				}
+				// everything writing into dataCh has to be gone before it is closed
+				devices.stopAll()
+				dialout.stop()
+				udp.stop()
+				cancel()
+				<-reportDone
+				<-outsReportDone
+				close(outs.dataCh)
+				<-outs.done
+				logInfoEvent(shutdownTopic, "done", "closing db")
+				return
 			}
-			cfgs = append(cfgs, newCfg)
-			go d.prepConAndSubscribe()
-			logInfoEvent(grpcTopic, " new device", "starting gorutine for a new device")
 		}
-
 	}
 	err := app.Run(os.Args)
 	if err != nil {
@@ -182,71 +298,146 @@ func main() {
 	}
 }
 
-func (d *device) prepConAndSubscribe() {
+// start runs the device until stop is called, devices which dial out
+// to us have nothing to run
+func (d *device) start() {
+	if d.cfg.DialOut {
+		logInfoEvent(dialoutTopic, " new device", "waiting for the device to connect")
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+	d.done = make(chan struct{})
+	go func() {
+		defer close(d.done)
+		d.supervise(ctx)
+	}()
+}
+
+// stop cancels the running session and waits for it to wind down,
+// streams of a device dialing in are closed the same way
+func (d *device) stop() {
+	d.endStreams()
+	if d.cancel == nil {
+		return
+	}
+	d.cancel()
+	<-d.done
+	d.cancel = nil
+}
+
+// supervise keeps a device subscribed, whatever goes wrong with a session
+// only this device backs off and tries again, others are not affected
+func (d *device) supervise(ctx context.Context) {
 	logInfoEvent(grpcTopic, "preping collection", fmt.Sprintf("hostname: %s port: %d", d.cfg.Host, d.cfg.Port))
-	defer d.cfg.RUnlock()
-	var conn *grpc.ClientConn
+	d.Opts = nil
+	err := addDialOptions(d)
+	if err != nil {
+		logErrEvent(grpcTopic, grpcDialOptsErrEv, err)
+		return
+	}
+	var attempt uint
 	for {
-		err := addDialOptions(d)
-		if err != nil {
-			logErrEvent(grpcTopic, grpcDialOptsErrEv, err)
+		started := time.Now()
+		err := d.session(ctx)
+		d.transition(stateDisconnected)
+		if ctx.Err() != nil {
+			logInfoEvent(grpcTopic, "session cancelled", fmt.Sprintf("hostname: %s port: %d", d.cfg.Host, d.cfg.Port))
 			return
 		}
-		hostname := d.cfg.Host + ":" + strconv.Itoa(d.cfg.Port)
-		conn, err = grpc.Dial(hostname, d.Opts...)
+		// a session ending without an error got all there was e.g. a gNMI ONCE subscription
+		if err == nil {
+			logInfoEvent(grpcTopic, "session done", fmt.Sprintf("hostname: %s port: %d", d.cfg.Host, d.cfg.Port))
+			return
+		}
+		// a session which lasted a while was a success, start backing off from scratch
+		if time.Since(started) > maxBackoff {
+			attempt = 0
+		}
+		wait := backoff(attempt)
+		attempt++
+		logErrEvent(grpcTopic, grpcRetryEv, fmt.Errorf("hostname: %s port: %d: %v, retrying in %s", d.cfg.Host, d.cfg.Port, err, wait))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// backoff is exponential with jitter so devices which failed together
+// do not all come back at the same moment
+func backoff(attempt uint) time.Duration {
+	wait := maxBackoff
+	if attempt < 16 {
+		wait = minBackoff << attempt
+		if wait > maxBackoff {
+			wait = maxBackoff
+		}
+	}
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+func (d *device) transition(state string) {
+	d.Stats.setState(state)
+	logInfoEvent(grpcTopic, "state "+state, fmt.Sprintf("hostname: %s port: %d", d.cfg.Host, d.cfg.Port))
+}
+
+// session connects, logs in and subscribes, it returns once the stream is broken
+// or with nil once a subscription which does not stream is complete
+func (d *device) session(ctx context.Context) error {
+	hostname := d.cfg.Host + ":" + strconv.Itoa(d.cfg.Port)
+	d.transition(stateConnecting)
+	dialCtx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(dialCtx, hostname, append(d.Opts, grpc.WithBlock())...)
+	if err != nil {
+		return fmt.Errorf("%s: %v", grpcConnErrEv, err)
+	}
+	defer conn.Close()
+	// gNMI carries credentials in metadata of every RPC, no login needed
+	if d.cfg.Protocol != protoGNMI && d.cfg.User != "" && d.cfg.Password != "" && !d.cfg.Meta {
+		dat, err := auth_pb.NewLoginClient(conn).LoginCheck(ctx, &auth_pb.LoginRequest{
+			UserName: d.cfg.User,
+			Password: d.cfg.Password,
+			ClientId: d.cfg.CID,
+		})
 		if err != nil {
-			logFatal(grpcTopic, grpcConnErrEv, err)
+			d.Stats.setLogin(err.Error())
+			return fmt.Errorf("%s: %v", grpcLoginErrEv, err)
 		}
-		defer conn.Close()
-		if d.cfg.User != "" && d.cfg.Password != "" {
-			user := d.cfg.User
-			pass := d.cfg.Password
-			if !d.cfg.Meta {
-				d.cfg.RLock()
-				if d.cfg.Removed {
-					logInfoEvent(grpcTopic, "device removed", "exiting gorutine")
-					return
-				}
-				d.cfg.RUnlock()
-				dat, err := auth_pb.NewLoginClient(conn).LoginCheck(context.Background(), &auth_pb.LoginRequest{
-					UserName: user,
-					Password: pass,
-					ClientId: d.cfg.CID,
-				})
-				if err != nil {
-					logErrEvent(grpcTopic, grpcLoginErrEv, err)
-					time.Sleep(10 * time.Second)
-					continue
-				}
-				if !dat.Result {
-					logErrEvent(grpcTopic, grpcAuthErrEv, errors.New("login failure"))
-				} else {
-					break
-				}
-			}
+		if !dat.Result {
+			d.Stats.setLogin(loginFailed)
+			return errors.New(grpcAuthErrEv)
 		}
-		time.Sleep(10 * time.Second)
+		d.Stats.setLogin(loginOK)
+	}
+	switch d.cfg.Protocol {
+	case protoGNMI:
+		return d.gnmiSubscribe(ctx, conn)
+	default:
+		return d.subscribe(ctx, conn)
 	}
-	d.subscribe(conn)
 }
 
 func addDialOptions(d *device) error {
 	if d.cfg.TLS.CA != "" {
 		cert, err := tls.LoadX509KeyPair(d.cfg.TLS.ClientCrt, d.cfg.TLS.ClientKey)
 		if err != nil {
-			logFatal(tlsLogTopic, tlsLogTopic, err)
+			logErrEvent(tlsLogTopic, tlsLogTopic, err)
 			return err
 		}
 		certPool := x509.NewCertPool()
 		caInBytes, err := ioutil.ReadFile(d.cfg.TLS.CA)
 		if err != nil {
-			logFatal(tlsLogTopic, tlsCAReadEv, err)
+			logErrEvent(tlsLogTopic, tlsCAReadEv, err)
 			return err
 		}
 		ok := certPool.AppendCertsFromPEM(caInBytes)
 		if !ok {
-			logFatal(tlsLogTopic, tlsCertAppendEv, err)
-			return errors.New("AppendCertsFromPEM err")
+			err = errors.New("AppendCertsFromPEM err")
+			logErrEvent(tlsLogTopic, tlsCertAppendEv, err)
+			return err
 		}
 		transportCreds := credentials.NewTLS(&tls.Config{
 			Certificates: []tls.Certificate{cert},
@@ -0,0 +1,445 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultBatchSize     = 100
+	defaultFlushInterval = time.Second
+	defaultWriters       = 1
+	defaultSpoolMaxMB    = 1024
+	defaultSpoolRetry    = 10 * time.Second
+	// records decoders may get ahead of outputs before they block
+	defaultPipelineBuffer = 10000
+)
+
+//Record is a decoded piece of data in a form every output understands,
+//outputs share records so they must not modify them
+type Record struct {
+	Measurement string
+	Tags        map[string]string
+	Fields      map[string]interface{}
+	Timestamp   time.Time
+}
+
+// dataPoint is what decoders send down the pipeline, every stats struct turns itself into a record
+type dataPoint interface {
+	Record() Record
+}
+
+//Output is a sink records are written to, every configured output gets its own
+//batches and decides on its own what to do when a write fails
+type Output interface {
+	Write(recs []Record) error
+	Close() error
+}
+
+// permanentErr is a write the backend refused for good, spooling it would only block the queue
+type permanentErr struct {
+	error
+}
+
+// outputStatser is an output keeping track of its own health,
+// it is reported along with the collector self monitoring points
+type outputStatser interface {
+	stats() map[string]interface{}
+}
+
+//OutputStats health of a single output
+type OutputStats struct {
+	Name      string
+	Type      string
+	Fields    map[string]interface{}
+	Timestamp time.Time
+}
+
+//Record turns stats into a record for outputs
+func (st *OutputStats) Record() Record {
+	return Record{
+		Measurement: metricOutput,
+		Tags: map[string]string{
+			"name": st.Name,
+			"type": st.Type,
+		},
+		Fields:    st.Fields,
+		Timestamp: st.Timestamp,
+	}
+}
+
+// outputTypes maps the type key of an [[output]] section onto a constructor
+var outputTypes = map[string]func(cfg *viper.Viper) (Output, error){
+	"influx":       newInfluxOutput,
+	"influx2":      newInflux2Output,
+	"prometheus":   newPromOutput,
+	"remote_write": newRemoteWrite,
+	"kafka":        newKafkaOutput,
+}
+
+// outputRunner batches records for a single output and hands batches to a pool of writers,
+// a batch is sent when it is full or when the flush interval is over, whichever comes first
+type outputRunner struct {
+	name          string
+	typ           string
+	out           Output
+	batchSize     int
+	flushInterval time.Duration
+	writers       int
+	ch            chan Record
+	// batches waiting for a writer, its capacity bounds batches in flight,
+	// a nil batch asks a writer to replay the spool
+	batches chan []Record
+	done    chan struct{}
+	// batches which could not be written wait here, nil without a spool_dir
+	spool      *spool
+	spoolRetry time.Duration
+	// only one writer replays at a time or batches would be sent twice
+	replayMu sync.Mutex
+	// write stats since the last report
+	statsMu    sync.Mutex
+	writes     int64
+	writeErrs  int64
+	latencySum time.Duration
+	latencyMax time.Duration
+}
+
+// outputs fans records out to all configured outputs
+type outputs struct {
+	dataCh  chan dataPoint
+	runners []*outputRunner
+	// closed once dataCh is drained and every output wrote its last batch
+	done chan struct{}
+}
+
+// newOutputs creates outputs from the [[output]] sections of the config,
+// a lone [influx] section of older configs still works
+func newOutputs() (*outputs, error) {
+	cfgs, err := outputCfgs()
+	if err != nil {
+		return nil, err
+	}
+	buffer := viper.GetInt("pipeline.buffer")
+	if buffer <= 0 {
+		buffer = defaultPipelineBuffer
+	}
+	o := &outputs{
+		dataCh: make(chan dataPoint, buffer),
+		done:   make(chan struct{}),
+	}
+	for i, cfg := range cfgs {
+		typ := cfg.GetString("type")
+		newOutput, ok := outputTypes[typ]
+		if !ok {
+			return nil, fmt.Errorf("%s: %q", outputTypeErrEv, typ)
+		}
+		name := cfg.GetString("name")
+		if name == "" {
+			name = fmt.Sprintf("%s-%d", typ, i)
+		}
+		out, err := newOutput(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("output %s: %v", name, err)
+		}
+		batchSize := cfg.GetInt("batch_size")
+		if batchSize <= 0 {
+			batchSize = defaultBatchSize
+		}
+		r := &outputRunner{
+			name:          name,
+			typ:           typ,
+			out:           out,
+			batchSize:     batchSize,
+			flushInterval: cfg.GetDuration("flush_interval"),
+			writers:       cfg.GetInt("writers"),
+			ch:            make(chan Record, batchSize),
+			done:          make(chan struct{}),
+		}
+		if r.flushInterval <= 0 {
+			r.flushInterval = defaultFlushInterval
+		}
+		if r.writers <= 0 {
+			r.writers = defaultWriters
+		}
+		inFlight := cfg.GetInt("max_in_flight")
+		if inFlight <= 0 {
+			inFlight = 2 * r.writers
+		}
+		r.batches = make(chan []Record, inFlight)
+		if dir := cfg.GetString("spool_dir"); dir != "" {
+			maxMB := cfg.GetInt64("spool_max_mb")
+			if maxMB <= 0 {
+				maxMB = defaultSpoolMaxMB
+			}
+			r.spool, err = openSpool(filepath.Join(dir, name+".spool"), maxMB<<20)
+			if err != nil {
+				return nil, fmt.Errorf("output %s spool: %v", name, err)
+			}
+			r.spoolRetry = cfg.GetDuration("spool_retry")
+			if r.spoolRetry <= 0 {
+				r.spoolRetry = defaultSpoolRetry
+			}
+		}
+		o.runners = append(o.runners, r)
+		logInfoEvent(outputTopic, "output configured", fmt.Sprintf("name: %s type: %s", name, typ))
+	}
+	if len(o.runners) == 0 {
+		logInfoEvent(outputTopic, outputNoneEv, "")
+	}
+	return o, nil
+}
+
+func outputCfgs() ([]*viper.Viper, error) {
+	var entries []map[string]interface{}
+	switch v := viper.Get("output").(type) {
+	case nil:
+	case []map[string]interface{}:
+		entries = v
+	case []interface{}:
+		for _, e := range v {
+			m, ok := e.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("output entry is %T, expected a table", e)
+			}
+			entries = append(entries, m)
+		}
+	default:
+		return nil, fmt.Errorf("output is %T, expected [[output]] tables", v)
+	}
+	if len(entries) == 0 && viper.IsSet("influx") {
+		legacy := viper.GetStringMap("influx")
+		legacy["type"] = "influx"
+		legacy["batch_size"] = viper.GetInt("influx.batchsize")
+		entries = append(entries, legacy)
+	}
+	var cfgs []*viper.Viper
+	for _, e := range entries {
+		cfg := viper.New()
+		err := cfg.MergeConfigMap(e)
+		if err != nil {
+			return nil, err
+		}
+		cfgs = append(cfgs, cfg)
+	}
+	return cfgs, nil
+}
+
+func (o *outputs) start() {
+	for _, r := range o.runners {
+		go r.run()
+	}
+	go o.run()
+}
+
+// run hands every record to each output, a slow output slows down all of them
+func (o *outputs) run() {
+	defer close(o.done)
+	for p := range o.dataCh {
+		rec := p.Record()
+		for _, r := range o.runners {
+			r.ch <- rec
+		}
+	}
+	for _, r := range o.runners {
+		close(r.ch)
+	}
+	for _, r := range o.runners {
+		<-r.done
+	}
+}
+
+// report sends stats of every output each interval until ctx is done
+func (o *outputs) report(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, r := range o.runners {
+				fields := r.stats()
+				if st, ok := r.out.(outputStatser); ok {
+					for k, v := range st.stats() {
+						fields[k] = v
+					}
+				}
+				if r.spool != nil {
+					for k, v := range r.spool.stats(now) {
+						fields[k] = v
+					}
+				}
+				o.dataCh <- &OutputStats{
+					Name:      r.name,
+					Type:      r.typ,
+					Fields:    fields,
+					Timestamp: now,
+				}
+			}
+		}
+	}
+}
+
+func (r *outputRunner) run() {
+	defer close(r.done)
+	var wg sync.WaitGroup
+	for i := 0; i < r.writers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range r.batches {
+				if batch == nil {
+					r.replay()
+					continue
+				}
+				r.write(batch)
+			}
+		}()
+	}
+	var retry <-chan time.Time
+	if r.spool != nil {
+		ticker := time.NewTicker(r.spoolRetry)
+		defer ticker.Stop()
+		retry = ticker.C
+	}
+	flush := time.NewTicker(r.flushInterval)
+	defer flush.Stop()
+	batch := make([]Record, 0, r.batchSize)
+loop:
+	for {
+		select {
+		case rec, ok := <-r.ch:
+			if !ok {
+				break loop
+			}
+			batch = append(batch, rec)
+			if len(batch) >= r.batchSize {
+				r.batches <- batch
+				batch = make([]Record, 0, r.batchSize)
+			}
+		case <-flush.C:
+			if len(batch) > 0 {
+				r.batches <- batch
+				batch = make([]Record, 0, r.batchSize)
+			}
+		case <-retry:
+			// a replay already waiting is enough
+			select {
+			case r.batches <- nil:
+			default:
+			}
+		}
+	}
+	// whatever is left in a partial batch on shutdown
+	if len(batch) > 0 {
+		r.batches <- batch
+	}
+	close(r.batches)
+	wg.Wait()
+	err := r.out.Close()
+	if err != nil {
+		logErrEvent(outputTopic, outputCloseErrEv, fmt.Errorf("%s: %v", r.name, err))
+	}
+	if r.spool != nil {
+		err = r.spool.close()
+		if err != nil {
+			logErrEvent(outputTopic, outputCloseErrEv, fmt.Errorf("%s spool: %v", r.name, err))
+		}
+	}
+}
+
+// send writes a batch and keeps track of how long it took
+func (r *outputRunner) send(batch []Record) error {
+	start := time.Now()
+	err := r.out.Write(batch)
+	took := time.Since(start)
+	r.statsMu.Lock()
+	defer r.statsMu.Unlock()
+	r.writes++
+	if err != nil {
+		r.writeErrs++
+	}
+	r.latencySum += took
+	if took > r.latencyMax {
+		r.latencyMax = took
+	}
+	return err
+}
+
+// stats are write counts and latency since the last call and what is queued right now
+func (r *outputRunner) stats() map[string]interface{} {
+	r.statsMu.Lock()
+	defer r.statsMu.Unlock()
+	var avg time.Duration
+	if r.writes > 0 {
+		avg = r.latencySum / time.Duration(r.writes)
+	}
+	st := map[string]interface{}{
+		"writes":               r.writes,
+		"write_errors":         r.writeErrs,
+		"write_latency_avg_ms": float64(avg) / float64(time.Millisecond),
+		"write_latency_max_ms": float64(r.latencyMax) / float64(time.Millisecond),
+		"queued_records":       int64(len(r.ch)),
+		"in_flight_batches":    int64(len(r.batches)),
+	}
+	r.writes, r.writeErrs, r.latencySum, r.latencyMax = 0, 0, 0, 0
+	return st
+}
+
+func (r *outputRunner) write(batch []Record) {
+	// with batches waiting in the spool a new one goes behind them to keep the order
+	if r.spool != nil && !r.spool.empty() {
+		r.toSpool(batch)
+		r.replay()
+		return
+	}
+	err := r.send(batch)
+	if err == nil {
+		return
+	}
+	logErrEvent(outputTopic, outputWriteErrEv, fmt.Errorf("%s: write of %d records: %v", r.name, len(batch), err))
+	if _, ok := err.(permanentErr); ok || r.spool == nil {
+		return
+	}
+	r.toSpool(batch)
+}
+
+func (r *outputRunner) toSpool(batch []Record) {
+	err := r.spool.push(batch)
+	if err != nil {
+		logErrEvent(outputTopic, outputSpoolErrEv, fmt.Errorf("%s: dropped %d records: %v", r.name, len(batch), err))
+	}
+}
+
+// replay writes spooled batches oldest first and stops as soon as the backend fails again
+func (r *outputRunner) replay() {
+	r.replayMu.Lock()
+	defer r.replayMu.Unlock()
+	for i := 0; i < spoolReplayBatches; i++ {
+		key, batch, err := r.spool.oldest()
+		if err != nil {
+			logErrEvent(outputTopic, outputSpoolErrEv, fmt.Errorf("%s: %v", r.name, err))
+			return
+		}
+		if batch == nil {
+			return
+		}
+		err = r.send(batch.Records)
+		if err != nil {
+			if _, ok := err.(permanentErr); !ok {
+				return
+			}
+			logErrEvent(outputTopic, outputWriteErrEv, fmt.Errorf("%s: dropped %d spooled records: %v", r.name, len(batch.Records), err))
+		}
+		err = r.spool.remove(key)
+		if err != nil {
+			logErrEvent(outputTopic, outputSpoolErrEv, fmt.Errorf("%s: %v", r.name, err))
+			return
+		}
+	}
+}
@@ -0,0 +1,345 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// fakeOutput keeps what it was sent, err decides how a write goes
+type fakeOutput struct {
+	mu      sync.Mutex
+	batches [][]Record
+	err     func(batch []Record) error
+	closed  bool
+}
+
+func (o *fakeOutput) Write(recs []Record) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.err != nil {
+		if err := o.err(recs); err != nil {
+			return err
+		}
+	}
+	o.batches = append(o.batches, recs)
+	return nil
+}
+
+func (o *fakeOutput) Close() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.closed = true
+	return nil
+}
+
+// sent is the number of batches and records written so far
+func (o *fakeOutput) sent() (int, int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	recs := 0
+	for _, b := range o.batches {
+		recs += len(b)
+	}
+	return len(o.batches), recs
+}
+
+// useConfig swaps the global config for the rest of a test
+func useConfig(t *testing.T, toml string) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.SetConfigType("toml")
+	err := viper.ReadConfig(strings.NewReader(toml))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// useFakeOutputs makes outputs of type fake write into the outputs returned, in config order
+func useFakeOutputs(t *testing.T) *[]*fakeOutput {
+	var fakes []*fakeOutput
+	outputTypes["fake"] = func(cfg *viper.Viper) (Output, error) {
+		o := &fakeOutput{}
+		fakes = append(fakes, o)
+		return o, nil
+	}
+	t.Cleanup(func() { delete(outputTypes, "fake") })
+	return &fakes
+}
+
+func TestOutputCfgs(t *testing.T) {
+	tests := []struct {
+		name  string
+		toml  string
+		types []string
+		err   bool
+	}{
+		{"outputs", `
+[[output]]
+type = "influx"
+[[output]]
+type = "kafka"`, []string{"influx", "kafka"}, false},
+		{"legacy influx section", `
+[influx]
+server = "localhost"
+batchsize = 7`, []string{"influx"}, false},
+		{"outputs win over the legacy section", `
+[influx]
+server = "localhost"
+[[output]]
+type = "prometheus"`, []string{"prometheus"}, false},
+		{"none", ``, nil, false},
+		{"not a table", `output = "influx"`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useConfig(t, tt.toml)
+			cfgs, err := outputCfgs()
+			if (err != nil) != tt.err {
+				t.Fatalf("error %v", err)
+			}
+			var types []string
+			for _, cfg := range cfgs {
+				types = append(types, cfg.GetString("type"))
+			}
+			if strings.Join(types, ",") != strings.Join(tt.types, ",") {
+				t.Errorf("got %v, want %v", types, tt.types)
+			}
+		})
+	}
+	useConfig(t, `
+[influx]
+batchsize = 7`)
+	cfgs, _ := outputCfgs()
+	if cfgs[0].GetInt("batch_size") != 7 {
+		t.Errorf("legacy batchsize %d", cfgs[0].GetInt("batch_size"))
+	}
+}
+
+func TestNewOutputs(t *testing.T) {
+	useFakeOutputs(t)
+	useConfig(t, `
+[[output]]
+type = "fake"
+[[output]]
+type = "fake"
+name = "second"
+batch_size = 10
+flush_interval = "2s"
+writers = 3`)
+	o, err := newOutputs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, second := o.runners[0], o.runners[1]
+	if first.name != "fake-0" || first.batchSize != defaultBatchSize || first.flushInterval != defaultFlushInterval ||
+		first.writers != defaultWriters || cap(first.batches) != 2*defaultWriters || first.spool != nil {
+		t.Errorf("defaults: got %+v", first)
+	}
+	if second.name != "second" || second.batchSize != 10 || second.flushInterval != 2*time.Second || second.writers != 3 {
+		t.Errorf("configured: got %+v", second)
+	}
+
+	useConfig(t, `
+[[output]]
+type = "nope"`)
+	_, err = newOutputs()
+	if err == nil || !strings.Contains(err.Error(), outputTypeErrEv) {
+		t.Errorf("unknown type: got %v", err)
+	}
+}
+
+func TestOutputsFanOut(t *testing.T) {
+	fakes := useFakeOutputs(t)
+	useConfig(t, `
+[[output]]
+type = "fake"
+[[output]]
+type = "fake"
+batch_size = 2`)
+	o, err := newOutputs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	o.start()
+	for i := 0; i < 3; i++ {
+		o.dataCh <- &InterfaceEvent{Host: "r1", Name: "xe-0/0/0", Event: ifEventRemoved, Timestamp: time.Now()}
+	}
+	close(o.dataCh)
+	<-o.done
+	for i, f := range *fakes {
+		_, recs := f.sent()
+		if recs != 3 || !f.closed {
+			t.Errorf("output %d: %d records, closed %v", i, recs, f.closed)
+		}
+	}
+}
+
+func newTestRunner(out Output, batchSize, writers int, flush time.Duration) *outputRunner {
+	return &outputRunner{
+		name:          "test",
+		out:           out,
+		batchSize:     batchSize,
+		flushInterval: flush,
+		writers:       writers,
+		ch:            make(chan Record, batchSize),
+		batches:       make(chan []Record, 2*writers),
+		done:          make(chan struct{}),
+	}
+}
+
+func runnerRec(n int64) Record {
+	return Record{Measurement: metricPhyIf, Tags: map[string]string{"host": "r1"}, Fields: map[string]interface{}{"n": n}}
+}
+
+// written is the n field of every record the output got, in the order of its batches
+func (o *fakeOutput) written() []int64 {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var got []int64
+	for _, b := range o.batches {
+		for _, r := range b {
+			got = append(got, r.Fields["n"].(int64))
+		}
+	}
+	return got
+}
+
+func eventually(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if cond() {
+			return
+		}
+	}
+	t.Fatalf("timed out waiting for %s", what)
+}
+
+func TestRunnerBatches(t *testing.T) {
+	out := &fakeOutput{}
+	r := newTestRunner(out, 3, 1, time.Hour)
+	go r.run()
+	for n := int64(1); n <= 7; n++ {
+		r.ch <- runnerRec(n)
+	}
+	// full batches go right away, the rest on shutdown before the output is closed
+	eventually(t, "two full batches", func() bool { b, _ := out.sent(); return b == 2 })
+	close(r.ch)
+	<-r.done
+	var sizes []int
+	for _, b := range out.batches {
+		sizes = append(sizes, len(b))
+	}
+	if fmt.Sprint(sizes) != "[3 3 1]" || fmt.Sprint(out.written()) != "[1 2 3 4 5 6 7]" || !out.closed {
+		t.Errorf("batches %v records %v closed %v", sizes, out.written(), out.closed)
+	}
+	st := r.stats()
+	if st["writes"] != int64(3) || st["write_errors"] != int64(0) {
+		t.Errorf("stats %v", st)
+	}
+}
+
+func TestRunnerFlush(t *testing.T) {
+	out := &fakeOutput{}
+	r := newTestRunner(out, 100, 1, 10*time.Millisecond)
+	go r.run()
+	r.ch <- runnerRec(1)
+	r.ch <- runnerRec(2)
+	eventually(t, "the flush", func() bool { b, _ := out.sent(); return b == 1 })
+	close(r.ch)
+	<-r.done
+	if fmt.Sprint(out.written()) != "[1 2]" {
+		t.Errorf("got %v", out.written())
+	}
+}
+
+func TestRunnerWriters(t *testing.T) {
+	var busy, most int32
+	out := &fakeOutput{}
+	// the fake holds its lock while writing, count writes in progress around it
+	w := writerCounter{out, &busy, &most}
+	r := newTestRunner(w, 1, 4, time.Hour)
+	go r.run()
+	for n := int64(0); n < 20; n++ {
+		r.ch <- runnerRec(n)
+	}
+	close(r.ch)
+	<-r.done
+	if b, recs := out.sent(); b != 20 || recs != 20 || !out.closed {
+		t.Errorf("%d batches %d records closed %v", b, recs, out.closed)
+	}
+	if atomic.LoadInt32(&most) < 2 {
+		t.Errorf("at most %d writes at a time with 4 writers", most)
+	}
+}
+
+type writerCounter struct {
+	*fakeOutput
+	busy, most *int32
+}
+
+func (w writerCounter) Write(recs []Record) error {
+	n := atomic.AddInt32(w.busy, 1)
+	defer atomic.AddInt32(w.busy, -1)
+	for {
+		m := atomic.LoadInt32(w.most)
+		if n <= m || atomic.CompareAndSwapInt32(w.most, m, n) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+	return w.fakeOutput.Write(recs)
+}
+
+func TestRunnerSpool(t *testing.T) {
+	var down, attempts int32
+	out := &fakeOutput{err: func(batch []Record) error {
+		atomic.AddInt32(&attempts, 1)
+		if batch[0].Fields["n"].(int64) == 0 {
+			return permanentErr{errors.New("refused")}
+		}
+		if atomic.LoadInt32(&down) == 1 {
+			return errors.New("unavailable")
+		}
+		return nil
+	}}
+	r := newTestRunner(out, 1, 1, time.Hour)
+	var err error
+	r.spool, err = openSpool(filepath.Join(t.TempDir(), "test.spool"), 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.spoolRetry = 10 * time.Millisecond
+	go r.run()
+
+	// refused batches are dropped, spooling them would only block the others
+	r.ch <- runnerRec(0)
+	eventually(t, "the refused write", func() bool { return atomic.LoadInt32(&attempts) == 1 })
+	if !r.spool.empty() {
+		t.Error("refused batch was spooled")
+	}
+
+	// failed ones wait in the spool, later ones behind them, and go out in order once the backend is back
+	atomic.StoreInt32(&down, 1)
+	for n := int64(1); n <= 3; n++ {
+		r.ch <- runnerRec(n)
+	}
+	eventually(t, "spooled batches", func() bool {
+		return fmt.Sprint(r.spool.stats(time.Now())["spool_batches"]) == "3"
+	})
+	r.ch <- runnerRec(4)
+	atomic.StoreInt32(&down, 0)
+	eventually(t, "the replay", func() bool { return r.spool.empty() })
+	close(r.ch)
+	<-r.done
+	if fmt.Sprint(out.written()) != "[1 2 3 4]" {
+		t.Errorf("got %v", out.written())
+	}
+}
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultPromPath   = "/metrics"
+	defaultPromExpire = 5 * time.Minute
+	promCounter       = "counter"
+	promGauge         = "gauge"
+)
+
+// promMetric maps a record field onto a metric family
+type promMetric struct {
+	field string
+	name  string
+	typ   string
+	help  string
+}
+
+// promFamily is everything exported for one measurement, labels are taken from record tags
+// and renamed where the Prometheus convention differs
+type promFamily struct {
+	labels  map[string]string
+	metrics []promMetric
+}
+
+// only measurements listed here are exported, anything else is ignored by this output
+var promFamilies = map[string]promFamily{
+	metricPhyIf: {
+		labels: map[string]string{"host": "host", "name": "interface"},
+		metrics: []promMetric{
+			{"counters_in_octets", "sticoll_interface_in_octets_total", promCounter, "Octets received."},
+			{"counters_in_unicast_pkts", "sticoll_interface_in_unicast_pkts_total", promCounter, "Unicast packets received."},
+			{"counters_in_multicast_pkts", "sticoll_interface_in_multicast_pkts_total", promCounter, "Multicast packets received."},
+			{"counters_in_broadcast_pkts", "sticoll_interface_in_broadcast_pkts_total", promCounter, "Broadcast packets received."},
+			{"counters_in_errors", "sticoll_interface_in_errors_total", promCounter, "Input errors."},
+			{"counters_out_octets", "sticoll_interface_out_octets_total", promCounter, "Octets sent."},
+			{"counters_out_unicast_pkts", "sticoll_interface_out_unicast_pkts_total", promCounter, "Unicast packets sent."},
+			{"counters_out_multicast_pkts", "sticoll_interface_out_multicast_pkts_total", promCounter, "Multicast packets sent."},
+			{"counters_out_broadcast_pkts", "sticoll_interface_out_broadcast_pkts_total", promCounter, "Broadcast packets sent."},
+			{"carrier_transitions", "sticoll_interface_carrier_transitions_total", promCounter, "Carrier transitions."},
+			{"last_change", "sticoll_interface_last_change", promGauge, "Last state change as reported by the device."},
+			{"mtu", "sticoll_interface_mtu", promGauge, "Interface MTU."},
+			{"oper_state", "sticoll_interface_oper_up", promGauge, "1 if the interface is operationally up."},
+			{"admin_state", "sticoll_interface_admin_up", promGauge, "1 if the interface is administratively up."},
+		},
+	},
+	metricQueue: {
+		labels: map[string]string{"host": "host", "name": "interface", "queue": "queue", "forwarding_class": "forwarding_class"},
+		metrics: []promMetric{
+			{"pkts", "sticoll_queue_pkts_total", promCounter, "Packets transmitted from the queue."},
+			{"bytes", "sticoll_queue_bytes_total", promCounter, "Bytes transmitted from the queue."},
+			{"red_drop_pkts", "sticoll_queue_red_drop_pkts_total", promCounter, "Packets dropped by RED."},
+			{"red_drop_bytes", "sticoll_queue_red_drop_bytes_total", promCounter, "Bytes dropped by RED."},
+			{"tail_drop_pkts", "sticoll_queue_tail_drop_pkts_total", promCounter, "Packets tail dropped."},
+			{"avg_buffer_occupancy", "sticoll_queue_avg_buffer_occupancy", promGauge, "Average buffer occupancy."},
+			{"peak_buffer_occupancy", "sticoll_queue_peak_buffer_occupancy", promGauge, "Peak buffer occupancy."},
+			{"allocated_buffer_size", "sticoll_queue_allocated_buffer_size", promGauge, "Allocated buffer size."},
+		},
+	},
+}
+
+// promSeries is the latest state of one entity, say an interface of a host
+type promSeries struct {
+	measurement string
+	labels      string
+	host        string
+	ifName      string
+	values      map[string]float64
+	updated     time.Time
+}
+
+// promOutput keeps the latest values and serves them for scraping,
+// entities which stop reporting expire so removed interfaces do not stay forever
+type promOutput struct {
+	Addr   string
+	Path   string
+	Expire time.Duration
+	srv    *http.Server
+	sync.Mutex
+	series map[string]*promSeries
+}
+
+func newPromOutput(cfg *viper.Viper) (Output, error) {
+	p := &promOutput{
+		Addr:   cfg.GetString("address"),
+		Path:   cfg.GetString("path"),
+		Expire: cfg.GetDuration("expire"),
+		series: make(map[string]*promSeries),
+	}
+	if p.Addr == "" {
+		return nil, fmt.Errorf("prometheus output needs an address to listen on")
+	}
+	if p.Path == "" {
+		p.Path = defaultPromPath
+	}
+	if p.Expire <= 0 {
+		p.Expire = defaultPromExpire
+	}
+	mux := http.NewServeMux()
+	mux.Handle(p.Path, p)
+	p.srv = &http.Server{Addr: p.Addr, Handler: mux}
+	go func() {
+		err := p.srv.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			logFatal(promTopic, "failure to start prometheus listener", err)
+		}
+	}()
+	logInfoEvent(promTopic, "listening", p.Addr+p.Path)
+	return p, nil
+}
+
+//Write updates the latest values, nothing is sent anywhere until a scrape
+func (p *promOutput) Write(recs []Record) error {
+	now := time.Now()
+	p.Lock()
+	defer p.Unlock()
+	for _, r := range recs {
+		if r.Measurement == metricIfEvent && r.Fields["event"] == ifEventRemoved {
+			p.removeIf(r.Tags["host"], r.Tags["name"])
+			continue
+		}
+		fam, ok := promFamilies[r.Measurement]
+		if !ok {
+			continue
+		}
+		labels := promLabels(fam, r.Tags)
+		key := r.Measurement + labels
+		s, ok := p.series[key]
+		if !ok {
+			s = &promSeries{
+				measurement: r.Measurement,
+				labels:      labels,
+				host:        r.Tags["host"],
+				ifName:      r.Tags["name"],
+				values:      make(map[string]float64),
+			}
+			p.series[key] = s
+		}
+		for _, m := range fam.metrics {
+			if v, ok := promValue(r.Fields[m.field]); ok {
+				s.values[m.field] = v
+				continue
+			}
+			// states come as tags, they are exported as up or not
+			if state, ok := r.Tags[m.field]; ok && state != "" {
+				s.values[m.field] = 0
+				if strings.EqualFold(state, "UP") {
+					s.values[m.field] = 1
+				}
+			}
+		}
+		s.updated = now
+	}
+	return nil
+}
+
+// removeIf drops every series of an interface the device told us is gone
+func (p *promOutput) removeIf(host, name string) {
+	for key, s := range p.series {
+		if s.host == host && s.ifName == name {
+			delete(p.series, key)
+		}
+	}
+}
+
+func (p *promOutput) expire(now time.Time) {
+	for key, s := range p.series {
+		if now.Sub(s.updated) > p.Expire {
+			delete(p.series, key)
+		}
+	}
+}
+
+func (p *promOutput) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.Write([]byte(p.exposition(time.Now())))
+}
+
+// exposition renders the text format, families and series are sorted so scrapes are stable
+func (p *promOutput) exposition(now time.Time) string {
+	p.Lock()
+	defer p.Unlock()
+	p.expire(now)
+	byMeasurement := make(map[string][]*promSeries)
+	for _, s := range p.series {
+		byMeasurement[s.measurement] = append(byMeasurement[s.measurement], s)
+	}
+	var measurements []string
+	for m := range byMeasurement {
+		measurements = append(measurements, m)
+		sort.Slice(byMeasurement[m], func(i, j int) bool {
+			return byMeasurement[m][i].labels < byMeasurement[m][j].labels
+		})
+	}
+	sort.Strings(measurements)
+	var b strings.Builder
+	for _, m := range measurements {
+		for _, metric := range promFamilies[m].metrics {
+			header := false
+			for _, s := range byMeasurement[m] {
+				v, ok := s.values[metric.field]
+				if !ok {
+					continue
+				}
+				if !header {
+					fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", metric.name, metric.help, metric.name, metric.typ)
+					header = true
+				}
+				fmt.Fprintf(&b, "%s%s %s\n", metric.name, s.labels, strconv.FormatFloat(v, 'g', -1, 64))
+			}
+		}
+	}
+	return b.String()
+}
+
+//Close stops the listener
+func (p *promOutput) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), cancelTimeout)
+	defer cancel()
+	return p.srv.Shutdown(ctx)
+}
+
+// promLabels renders a label set once, it doubles as the series key
+func promLabels(fam promFamily, tags map[string]string) string {
+	var names []string
+	for tag := range fam.labels {
+		names = append(names, tag)
+	}
+	sort.Slice(names, func(i, j int) bool { return fam.labels[names[i]] < fam.labels[names[j]] })
+	var pairs []string
+	for _, tag := range names {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, fam.labels[tag], promEscape(tags[tag])))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+var promEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func promEscape(v string) string {
+	return promEscaper.Replace(v)
+}
+
+func promValue(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	case bool:
+		if n {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPromExposition(t *testing.T) {
+	p := &promOutput{Expire: time.Minute, series: make(map[string]*promSeries)}
+	now := time.Now()
+	err := p.Write([]Record{
+		{
+			Measurement: metricPhyIf,
+			Tags:        map[string]string{"host": "r1", "name": "xe-0/0/1", "oper_state": "DOWN", "desc": "not a label"},
+			Fields:      map[string]interface{}{"counters_in_octets": int64(5), "mtu": int64(1514)},
+		},
+		{
+			Measurement: metricPhyIf,
+			Tags:        map[string]string{"host": "r1", "name": "xe-0/0/0", "oper_state": "UP"},
+			Fields:      map[string]interface{}{"counters_in_octets": uint64(100)},
+		},
+		{
+			Measurement: metricQueue,
+			Tags:        map[string]string{"host": "r1", "name": "xe-0/0/0", "queue": "3", "forwarding_class": `say "hi"`},
+			Fields:      map[string]interface{}{"pkts": int64(7)},
+		},
+		{Measurement: "bgp_neighbor", Tags: map[string]string{"host": "r1"}, Fields: map[string]interface{}{"peer_as": int64(1)}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a walk reporting only some fields leaves the others as they were
+	p.Write([]Record{{
+		Measurement: metricPhyIf,
+		Tags:        map[string]string{"host": "r1", "name": "xe-0/0/1"},
+		Fields:      map[string]interface{}{"counters_in_octets": int64(6)},
+	}})
+	want := `# HELP sticoll_interface_in_octets_total Octets received.
+# TYPE sticoll_interface_in_octets_total counter
+sticoll_interface_in_octets_total{host="r1",interface="xe-0/0/0"} 100
+sticoll_interface_in_octets_total{host="r1",interface="xe-0/0/1"} 6
+# HELP sticoll_interface_mtu Interface MTU.
+# TYPE sticoll_interface_mtu gauge
+sticoll_interface_mtu{host="r1",interface="xe-0/0/1"} 1514
+# HELP sticoll_interface_oper_up 1 if the interface is operationally up.
+# TYPE sticoll_interface_oper_up gauge
+sticoll_interface_oper_up{host="r1",interface="xe-0/0/0"} 1
+sticoll_interface_oper_up{host="r1",interface="xe-0/0/1"} 0
+# HELP sticoll_queue_pkts_total Packets transmitted from the queue.
+# TYPE sticoll_queue_pkts_total counter
+sticoll_queue_pkts_total{forwarding_class="say \"hi\"",host="r1",interface="xe-0/0/0",queue="3"} 7
+`
+	if got := p.exposition(now); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	w := httptest.NewRecorder()
+	p.ServeHTTP(w, httptest.NewRequest("GET", defaultPromPath, nil))
+	if !strings.HasPrefix(w.Header().Get("Content-Type"), "text/plain; version=0.0.4") || w.Body.String() != want {
+		t.Errorf("scrape: %s\n%s", w.Header().Get("Content-Type"), w.Body.String())
+	}
+}
+
+func TestPromRemoval(t *testing.T) {
+	p := &promOutput{Expire: time.Minute, series: make(map[string]*promSeries)}
+	p.Write([]Record{
+		{Measurement: metricPhyIf, Tags: map[string]string{"host": "r1", "name": "xe-0/0/0"}, Fields: map[string]interface{}{"mtu": int64(1514)}},
+		{Measurement: metricQueue, Tags: map[string]string{"host": "r1", "name": "xe-0/0/0", "queue": "0"}, Fields: map[string]interface{}{"pkts": int64(1)}},
+		{Measurement: metricPhyIf, Tags: map[string]string{"host": "r2", "name": "xe-0/0/0"}, Fields: map[string]interface{}{"mtu": int64(9192)}},
+	})
+	// an interface going away takes its queues along, the same name on another host stays
+	p.Write([]Record{(&InterfaceEvent{Host: "r1", Name: "xe-0/0/0", Event: ifEventRemoved}).Record()})
+	want := `# HELP sticoll_interface_mtu Interface MTU.
+# TYPE sticoll_interface_mtu gauge
+sticoll_interface_mtu{host="r2",interface="xe-0/0/0"} 9192
+`
+	if got := p.exposition(time.Now()); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+	if got := p.exposition(time.Now().Add(2 * time.Minute)); got != "" {
+		t.Errorf("expired series are still there:\n%s", got)
+	}
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"sticoll/rest"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// deviceRegistry holds configured devices so listeners can work out
+// which device the data they received came from
+// and per device stats can be found and reported
+type deviceRegistry struct {
+	sync.RWMutex
+	devs    []*device
+	pointCh chan dataPoint
+	// bumped on every change so cached matches know they are stale
+	gen uint64
+}
+
+func newDeviceRegistry(pointCh chan dataPoint) *deviceRegistry {
+	return &deviceRegistry{
+		pointCh: pointCh,
+	}
+}
+
+func (r *deviceRegistry) add(cfg *rest.GRPCCfg) *device {
+	addrs := resolveHost(cfg.Host)
+	r.Lock()
+	defer r.Unlock()
+	d := &device{
+		cfg:     cfg,
+		pointCh: r.pointCh,
+		classes: newForwardingClasses(cfg),
+		addrs:   addrs,
+	}
+	r.devs = append(r.devs, d)
+	r.gen++
+	return d
+}
+
+// update restarts a device with its new config, stats are kept
+func (r *deviceRegistry) update(cfg *rest.GRPCCfg) {
+	d := r.byUUID(cfg.UUID)
+	if d == nil {
+		r.add(cfg).start()
+		return
+	}
+	addrs := resolveHost(cfg.Host)
+	d.stop()
+	d.cfgMu.Lock()
+	d.cfg = cfg
+	d.addrs = addrs
+	d.cfgMu.Unlock()
+	r.Lock()
+	r.gen++
+	r.Unlock()
+	d.classes.configure(cfg)
+	d.start()
+}
+
+// remove stops a device and forgets about it
+func (r *deviceRegistry) remove(id uuid.UUID) {
+	d := r.byUUID(id)
+	if d == nil {
+		return
+	}
+	// marked first so a device dialing in right now is not accepted after the stop
+	cfg := d.config()
+	cfg.Lock()
+	cfg.Removed = true
+	cfg.Unlock()
+	d.stop()
+	r.Lock()
+	defer r.Unlock()
+	for i, dev := range r.devs {
+		if dev == d {
+			r.devs = append(r.devs[:i], r.devs[i+1:]...)
+			break
+		}
+	}
+	r.gen++
+}
+
+func (r *deviceRegistry) generation() uint64 {
+	r.RLock()
+	defer r.RUnlock()
+	return r.gen
+}
+
+func (r *deviceRegistry) active() []*device {
+	r.RLock()
+	defer r.RUnlock()
+	var active []*device
+	for _, d := range r.devs {
+		cfg := d.config()
+		cfg.RLock()
+		if !cfg.Removed {
+			active = append(active, d)
+		}
+		cfg.RUnlock()
+	}
+	return active
+}
+
+// byIdentity matches TLS certificate names against the expected server name or host
+func (r *deviceRegistry) byIdentity(ids []string) *device {
+	for _, d := range r.active() {
+		cfg := d.config()
+		for _, id := range ids {
+			if id != "" && (id == cfg.TLS.ServerName || id == cfg.Host) {
+				return d
+			}
+		}
+	}
+	return nil
+}
+
+// byAddr matches against addresses resolved when devices were configured,
+// it is called for packets so it must not do lookups of its own
+func (r *deviceRegistry) byAddr(addr string) *device {
+	for _, d := range r.active() {
+		if d.hasAddr(addr) {
+			return d
+		}
+	}
+	return nil
+}
+
+// report sends collector side stats of every device as points
+// so the collector itself can be monitored like any other source
+func (r *deviceRegistry) report(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for _, d := range r.active() {
+			for _, p := range d.Stats.points(d.config().Host) {
+				r.pointCh <- p
+			}
+		}
+	}
+}
+
+// stopAll stops every running device, used on shutdown
+func (r *deviceRegistry) stopAll() {
+	for _, d := range r.active() {
+		d.stop()
+	}
+}
+
+func (r *deviceRegistry) byUUID(id uuid.UUID) *device {
+	for _, d := range r.active() {
+		if uuid.Equal(d.config().UUID, id) {
+			return d
+		}
+	}
+	return nil
+}
+
+//Latency implements rest.StatusProvider
+func (r *deviceRegistry) Latency(id uuid.UUID) ([]rest.SensorLatency, bool) {
+	d := r.byUUID(id)
+	if d == nil {
+		return nil, false
+	}
+	return d.Stats.latencyStats(), true
+}
+
+//Status implements rest.StatusProvider
+func (r *deviceRegistry) Status(id uuid.UUID) (rest.DeviceStatus, bool) {
+	d := r.byUUID(id)
+	if d == nil {
+		return rest.DeviceStatus{}, false
+	}
+	return d.Stats.status(d), true
+}
+
+//Statuses implements rest.StatusProvider
+func (r *deviceRegistry) Statuses() []rest.DeviceStatus {
+	active := r.active()
+	out := make([]rest.DeviceStatus, 0, len(active))
+	for _, d := range active {
+		out = append(out, d.Stats.status(d))
+	}
+	return out
+}
+
+// resolveHost is the set of addresses of a device, the host itself is one
+// so devices configured by address match even when the lookup fails.
+// A name moving to another address is picked up by updating the device.
+func resolveHost(host string) map[string]bool {
+	addrs := map[string]bool{host: true}
+	if net.ParseIP(host) != nil {
+		return addrs
+	}
+	found, err := net.LookupHost(host)
+	if err != nil {
+		logErrEvent(grpcTopic, grpcResolveErrEv, err)
+		return addrs
+	}
+	for _, a := range found {
+		addrs[a] = true
+	}
+	return addrs
+}
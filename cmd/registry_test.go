@@ -0,0 +1,54 @@
+package main
+
+import (
+	"sync"
+	"testing"
+
+	"sticoll/rest"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+func TestRegistryUpdate(t *testing.T) {
+	devices := newDeviceRegistry(make(chan dataPoint, 100))
+	id := uuid.NewV4()
+	// dial-out devices have no session to run
+	d := devices.add(&rest.GRPCCfg{UUID: id, Host: "192.0.2.1", DialOut: true})
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			devices.Statuses()
+			devices.byAddr("192.0.2.1")
+			devices.byIdentity([]string{"r1"})
+			devices.Status(id)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		host := "192.0.2.1"
+		if i%2 == 0 {
+			host = "192.0.2.2"
+		}
+		devices.update(&rest.GRPCCfg{UUID: id, Host: host, DialOut: true})
+	}
+	close(done)
+	wg.Wait()
+	if devices.byAddr("192.0.2.1") != d || devices.byAddr("192.0.2.2") != nil {
+		t.Error("device does not match its last address")
+	}
+	st, ok := devices.Status(id)
+	if !ok || st.Host != "192.0.2.1" {
+		t.Errorf("got %+v", st)
+	}
+	devices.remove(id)
+	if devices.byAddr("192.0.2.1") != nil || len(devices.Statuses()) != 0 {
+		t.Error("removed device is still there")
+	}
+}
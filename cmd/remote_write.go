@@ -0,0 +1,287 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"sticoll/prompb"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultRWShards     = 4
+	defaultRWRetries    = 5
+	defaultRWTimeout    = 30 * time.Second
+	defaultRWMinBackoff = 500 * time.Millisecond
+	defaultRWMaxBackoff = 30 * time.Second
+	// batches a shard may have queued before Write blocks
+	rwShardQueue = 16
+)
+
+// remoteWrite pushes records to a Prometheus remote_write receiver.
+// Series are spread over shards by their labels, so samples of one series always
+// go through the same shard and arrive in order while shards send in parallel.
+type remoteWrite struct {
+	URL         string
+	User        string
+	Pass        string
+	BearerToken string
+	Retries     int
+	MinBackoff  time.Duration
+	MaxBackoff  time.Duration
+	client      *http.Client
+	shards      []chan rwPush
+	wg          sync.WaitGroup
+	// samples pushed and ones a push failed for, after retries
+	sent   uint64
+	failed uint64
+}
+
+// rwPush is the part of a batch one shard sends, its result goes back to Write
+type rwPush struct {
+	series []*prompb.TimeSeries
+	result chan error
+}
+
+func newRemoteWrite(cfg *viper.Viper) (Output, error) {
+	rw := &remoteWrite{
+		URL:         cfg.GetString("url"),
+		User:        cfg.GetString("user"),
+		Pass:        cfg.GetString("pass"),
+		BearerToken: cfg.GetString("bearer_token"),
+		Retries:     defaultRWRetries,
+		MinBackoff:  defaultRWMinBackoff,
+		MaxBackoff:  defaultRWMaxBackoff,
+		client:      &http.Client{Timeout: defaultRWTimeout},
+	}
+	if rw.URL == "" {
+		return nil, fmt.Errorf("remote_write output needs a url")
+	}
+	if cfg.IsSet("retries") {
+		rw.Retries = cfg.GetInt("retries")
+	}
+	if d := cfg.GetDuration("min_backoff"); d > 0 {
+		rw.MinBackoff = d
+	}
+	if d := cfg.GetDuration("max_backoff"); d > 0 {
+		rw.MaxBackoff = d
+	}
+	if d := cfg.GetDuration("timeout"); d > 0 {
+		rw.client.Timeout = d
+	}
+	shards := cfg.GetInt("shards")
+	if shards <= 0 {
+		shards = defaultRWShards
+	}
+	for i := 0; i < shards; i++ {
+		ch := make(chan rwPush, rwShardQueue)
+		rw.shards = append(rw.shards, ch)
+		rw.wg.Add(1)
+		go rw.runShard(ch)
+	}
+	return rw, nil
+}
+
+//Write converts records into series and waits for the shards to push them, failures are
+//retried by the shards first. A batch the receiver refused for good is a permanentErr,
+//when a retry may still help the whole batch fails so the runner can spool it.
+func (rw *remoteWrite) Write(recs []Record) error {
+	perShard := make([][]*prompb.TimeSeries, len(rw.shards))
+	for _, r := range recs {
+		for _, ts := range recordSeries(r) {
+			i := seriesShard(ts, len(rw.shards))
+			perShard[i] = append(perShard[i], ts)
+		}
+	}
+	results := make(chan error, len(rw.shards))
+	pushes := 0
+	for i, series := range perShard {
+		if len(series) > 0 {
+			rw.shards[i] <- rwPush{series: series, result: results}
+			pushes++
+		}
+	}
+	var first error
+	failed := 0
+	permanent := true
+	for i := 0; i < pushes; i++ {
+		err := <-results
+		if err == nil {
+			continue
+		}
+		failed++
+		if first == nil {
+			first = err
+		}
+		if e, ok := err.(*rwError); ok && e.recoverable {
+			permanent = false
+		}
+	}
+	if first == nil {
+		return nil
+	}
+	err := fmt.Errorf("%d of %d pushes failed: %v", failed, pushes, first)
+	if permanent {
+		return permanentErr{err}
+	}
+	return err
+}
+
+func (rw *remoteWrite) stats() map[string]interface{} {
+	return map[string]interface{}{
+		"sent_samples":   int64(atomic.LoadUint64(&rw.sent)),
+		"failed_samples": int64(atomic.LoadUint64(&rw.failed)),
+	}
+}
+
+//Close waits for the shards to finish, Write does not return before its pushes are done
+func (rw *remoteWrite) Close() error {
+	for _, ch := range rw.shards {
+		close(ch)
+	}
+	rw.wg.Wait()
+	return nil
+}
+
+func (rw *remoteWrite) runShard(ch chan rwPush) {
+	defer rw.wg.Done()
+	for p := range ch {
+		err := rw.send(p.series)
+		samples := uint64(0)
+		for _, ts := range p.series {
+			samples += uint64(len(ts.Samples))
+		}
+		if err != nil {
+			atomic.AddUint64(&rw.failed, samples)
+		} else {
+			atomic.AddUint64(&rw.sent, samples)
+		}
+		p.result <- err
+	}
+}
+
+// rwError is a failed push, recoverable ones are worth sending again
+type rwError struct {
+	err         error
+	recoverable bool
+}
+
+func (e *rwError) Error() string { return e.err.Error() }
+
+// send pushes one request, server errors and throttling are retried with backoff,
+// anything else the receiver refused will not get better on a retry
+func (rw *remoteWrite) send(series []*prompb.TimeSeries) error {
+	data, err := proto.Marshal(&prompb.WriteRequest{Timeseries: series})
+	if err != nil {
+		return err
+	}
+	body := snappy.Encode(nil, data)
+	wait := rw.MinBackoff
+	for attempt := 0; ; attempt++ {
+		err := rw.post(body)
+		if err == nil {
+			return nil
+		}
+		if !err.recoverable || attempt >= rw.Retries {
+			return err
+		}
+		logErrEvent(rwTopic, rwRetryEv, err)
+		time.Sleep(wait)
+		wait *= 2
+		if wait > rw.MaxBackoff {
+			wait = rw.MaxBackoff
+		}
+	}
+}
+
+func (rw *remoteWrite) post(body []byte) *rwError {
+	req, err := http.NewRequest(http.MethodPost, rw.URL, bytes.NewReader(body))
+	if err != nil {
+		return &rwError{err: err}
+	}
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("User-Agent", "sticoll")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+	if rw.User != "" {
+		req.SetBasicAuth(rw.User, rw.Pass)
+	}
+	if rw.BearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+rw.BearerToken)
+	}
+	resp, err := rw.client.Do(req)
+	if err != nil {
+		return &rwError{err: err, recoverable: true}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+	msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+	return &rwError{
+		err:         fmt.Errorf("server returned %s: %s", resp.Status, strings.TrimSpace(string(msg))),
+		recoverable: resp.StatusCode/100 == 5 || resp.StatusCode == http.StatusTooManyRequests,
+	}
+}
+
+// recordSeries makes a series out of every numeric field of a record,
+// tags become labels and the record timestamp is kept for the sample
+func recordSeries(r Record) []*prompb.TimeSeries {
+	var labels []*prompb.Label
+	for k, v := range r.Tags {
+		if v == "" {
+			continue
+		}
+		labels = append(labels, &prompb.Label{Name: promName(k), Value: v})
+	}
+	ts := r.Timestamp.UnixNano() / int64(time.Millisecond)
+	var out []*prompb.TimeSeries
+	for field, v := range r.Fields {
+		value, ok := promValue(v)
+		if !ok {
+			continue
+		}
+		series := &prompb.TimeSeries{
+			Labels:  append([]*prompb.Label{{Name: "__name__", Value: promName("sticoll_" + r.Measurement + "_" + field)}}, labels...),
+			Samples: []*prompb.Sample{{Value: value, Timestamp: ts}},
+		}
+		// receivers want labels sorted by name
+		sort.Slice(series.Labels, func(i, j int) bool { return series.Labels[i].Name < series.Labels[j].Name })
+		out = append(out, series)
+	}
+	return out
+}
+
+func seriesShard(ts *prompb.TimeSeries, shards int) int {
+	h := fnv.New32a()
+	for _, l := range ts.Labels {
+		h.Write([]byte(l.Name))
+		h.Write([]byte{0})
+		h.Write([]byte(l.Value))
+		h.Write([]byte{0})
+	}
+	return int(h.Sum32() % uint32(shards))
+}
+
+// promName replaces whatever is not allowed in metric and label names
+func promName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == ':' {
+			return r
+		}
+		return '_'
+	}, name)
+}
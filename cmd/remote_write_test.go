@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"sticoll/prompb"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/spf13/viper"
+)
+
+// seriesString is a series the way Prometheus shows it, with its samples
+func seriesString(ts *prompb.TimeSeries) string {
+	var labels []string
+	name := ""
+	for _, l := range ts.Labels {
+		if l.Name == "__name__" {
+			name = l.Value
+			continue
+		}
+		labels = append(labels, fmt.Sprintf("%s=%q", l.Name, l.Value))
+	}
+	var samples []string
+	for _, s := range ts.Samples {
+		samples = append(samples, fmt.Sprintf("%v@%d", s.Value, s.Timestamp))
+	}
+	return name + "{" + strings.Join(labels, ",") + "} " + strings.Join(samples, " ")
+}
+
+func TestRecordSeries(t *testing.T) {
+	r := Record{
+		Measurement: metricQueue,
+		Tags:        map[string]string{"host": "r1", "name": "xe-0/0/0", "forwarding-class": "best effort", "ae_name": ""},
+		Fields:      map[string]interface{}{"pkts": int64(7), "red_drop_pkts": uint64(1), "up": true, "desc": "not a number"},
+		Timestamp:   time.Unix(1600000000, 5000000),
+	}
+	var got []string
+	for _, ts := range recordSeries(r) {
+		for i := 1; i < len(ts.Labels); i++ {
+			if ts.Labels[i-1].Name >= ts.Labels[i].Name {
+				t.Errorf("labels not sorted: %v", ts.Labels)
+			}
+		}
+		got = append(got, seriesString(ts))
+	}
+	sort.Strings(got)
+	want := []string{
+		`sticoll_queue_stats_pkts{forwarding_class="best effort",host="r1",name="xe-0/0/0"} 7@1600000000005`,
+		`sticoll_queue_stats_red_drop_pkts{forwarding_class="best effort",host="r1",name="xe-0/0/0"} 1@1600000000005`,
+		`sticoll_queue_stats_up{forwarding_class="best effort",host="r1",name="xe-0/0/0"} 1@1600000000005`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestSeriesShard(t *testing.T) {
+	a := recordSeries(Record{Measurement: "m", Tags: map[string]string{"host": "r1"}, Fields: map[string]interface{}{"f": int64(1)}})[0]
+	b := recordSeries(Record{Measurement: "m", Tags: map[string]string{"host": "r1"}, Fields: map[string]interface{}{"f": int64(2)}})[0]
+	// samples of a series always take the same shard
+	if seriesShard(a, 4) != seriesShard(b, 4) {
+		t.Error("same series on different shards")
+	}
+	spread := make(map[int]bool)
+	for i := 0; i < 100; i++ {
+		ts := recordSeries(Record{Measurement: "m", Tags: map[string]string{"host": fmt.Sprint("r", i)}, Fields: map[string]interface{}{"f": int64(1)}})[0]
+		spread[seriesShard(ts, 4)] = true
+	}
+	if len(spread) != 4 {
+		t.Errorf("100 series on %d of 4 shards", len(spread))
+	}
+}
+
+// rwReceiver decodes pushes, status picks the response to each
+type rwReceiver struct {
+	mu     sync.Mutex
+	series []string
+	pushes int
+	status func(push int) int
+}
+
+func (rr *rwReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+	rr.pushes++
+	if r.Header.Get("Content-Encoding") != "snappy" || r.Header.Get("Content-Type") != "application/x-protobuf" ||
+		r.Header.Get("X-Prometheus-Remote-Write-Version") == "" || r.Header.Get("Authorization") != "Bearer secret" {
+		http.Error(w, "bad headers", http.StatusBadRequest)
+		return
+	}
+	if status := rr.status(rr.pushes); status != http.StatusOK {
+		http.Error(w, "nope", status)
+		return
+	}
+	compressed, _ := ioutil.ReadAll(r.Body)
+	data, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var req prompb.WriteRequest
+	err = proto.Unmarshal(data, &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	for _, ts := range req.Timeseries {
+		rr.series = append(rr.series, seriesString(ts))
+	}
+}
+
+func newTestRemoteWrite(t *testing.T, url string) *remoteWrite {
+	cfg := viper.New()
+	cfg.Set("url", url)
+	cfg.Set("bearer_token", "secret")
+	cfg.Set("shards", 2)
+	cfg.Set("retries", 2)
+	cfg.Set("min_backoff", "1ms")
+	out, err := newRemoteWrite(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out.(*remoteWrite)
+}
+
+func TestRemoteWrite(t *testing.T) {
+	recs := []Record{
+		{Measurement: metricPhyIf, Tags: map[string]string{"host": "r1", "name": "xe-0/0/0"}, Fields: map[string]interface{}{"mtu": int64(1514)}, Timestamp: time.Unix(1, 0)},
+		{Measurement: metricPhyIf, Tags: map[string]string{"host": "r2", "name": "xe-0/0/0"}, Fields: map[string]interface{}{"mtu": int64(9192)}, Timestamp: time.Unix(2, 0)},
+	}
+	tests := []struct {
+		name      string
+		status    func(push int) int
+		err       bool
+		permanent bool
+		sent      int64
+		failed    int64
+	}{
+		{"pushed", func(int) int { return http.StatusOK }, false, false, 2, 0},
+		{"retried until it worked", func(push int) int {
+			if push <= 2 {
+				return http.StatusServiceUnavailable
+			}
+			return http.StatusOK
+		}, false, false, 2, 0},
+		{"throttled for good", func(int) int { return http.StatusTooManyRequests }, true, false, 0, 2},
+		{"refused", func(int) int { return http.StatusBadRequest }, true, true, 0, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := &rwReceiver{status: tt.status}
+			srv := httptest.NewServer(rr)
+			defer srv.Close()
+			rw := newTestRemoteWrite(t, srv.URL)
+			err := rw.Write(recs)
+			rw.Close()
+			if (err != nil) != tt.err {
+				t.Fatalf("error %v", err)
+			}
+			if _, ok := err.(permanentErr); ok != tt.permanent {
+				t.Errorf("error %v is permanent %v, want %v", err, ok, tt.permanent)
+			}
+			st := rw.stats()
+			if st["sent_samples"] != tt.sent || st["failed_samples"] != tt.failed {
+				t.Errorf("got %v", st)
+			}
+			if tt.err {
+				return
+			}
+			sort.Strings(rr.series)
+			want := `sticoll_phy_interface_mtu{host="r1",name="xe-0/0/0"} 1514@1000` + "\n" +
+				`sticoll_phy_interface_mtu{host="r2",name="xe-0/0/0"} 9192@2000`
+			if got := strings.Join(rr.series, "\n"); got != want {
+				t.Errorf("got\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}
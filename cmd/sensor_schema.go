@@ -0,0 +1,431 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	na_pb "sticoll/telemetry"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultSchemaFile = "sensors.toml"
+	defaultEntityAttr = "name"
+	defaultEntityTag  = "name"
+	schemaInt         = "int"
+	schemaFloat       = "float"
+	schemaString      = "string"
+	schemaBool        = "bool"
+)
+
+// loaded once on start, decoders only read it
+var schemas = &sensorSchemas{
+	sensors: make(map[string]*sensorSchema),
+	groups:  make(map[string]*schemaGroup),
+}
+
+// sensorSchema says how the KVs of one sensor path become tags and fields.
+// Entities are split on __prefix__, the entity attribute of the prefix names them
+// and everything a sensor reports about an entity ends up in the entity of its group.
+// Keys are matched relative to the entity, so it does not matter whether
+// a device puts a part of the path into the prefix or into the key.
+type sensorSchema struct {
+	Path   string                 `mapstructure:"path"`
+	Group  string                 `mapstructure:"group"`
+	Entity string                 `mapstructure:"entity"`
+	Tags   map[string]string      `mapstructure:"tags"`
+	Fields map[string]schemaField `mapstructure:"fields"`
+	Lists  []*schemaList          `mapstructure:"list"`
+	// attributes of the prefix in front of the entity which become tags,
+	// e.g. the network instance of a BGP neighbor. They also tell apart
+	// entities with the same name such as a neighbor in two VRFs.
+	PrefixTags map[string]string `mapstructure:"prefix_tags"`
+	group      *schemaGroup
+}
+
+// schemaList describes keys of list elements e.g. egress-queue-info[queue-number='0']/packets.
+// By default fields of an element join the entity named after the element e.g. inet_in_pkts,
+// with a measurement every element is a point of its own carrying the element in tag
+// and with a group elements are entities of that group named <entity><join><element>.
+// Elements joining the entity may sum up all elements with the same attr e.g. the
+// forwarding classes of a family, otherwise the last one of them wins.
+type schemaList struct {
+	Key         string                 `mapstructure:"key"`
+	Attr        string                 `mapstructure:"attr"`
+	Tags        map[string]string      `mapstructure:"tags"`
+	Fields      map[string]schemaField `mapstructure:"fields"`
+	Measurement string                 `mapstructure:"measurement"`
+	Tag         string                 `mapstructure:"tag"`
+	StaticTags  map[string]string      `mapstructure:"static_tags"`
+	// elements are queue numbers, their forwarding class goes into this tag
+	ClassTag string `mapstructure:"class_tag"`
+	Group    string `mapstructure:"group"`
+	Join     string `mapstructure:"join"`
+	Sum      bool   `mapstructure:"sum"`
+	group    *schemaGroup
+}
+
+type schemaField struct {
+	Name string `mapstructure:"name"`
+	Type string `mapstructure:"type"`
+}
+
+// schemaGroup is an entity several sensors contribute to e.g. an interface
+// made of linecard counters and OpenConfig state, it is sent as one measurement
+type schemaGroup struct {
+	Name        string `mapstructure:"name"`
+	Measurement string `mapstructure:"measurement"`
+	EntityTag   string `mapstructure:"entity_tag"`
+	// sensors an entity needs data from before it is sent
+	Require []string `mapstructure:"require"`
+	// entity names are cut at the last split into split_tags,
+	// e.g. a unit ge-0/0/0.100 into its parent and unit number
+	Split     string   `mapstructure:"split"`
+	SplitTags []string `mapstructure:"split_tags"`
+	// a change of a watched tag or field of a known entity is sent as an event point
+	Watch  []string `mapstructure:"watch"`
+	Events string   `mapstructure:"events"`
+}
+
+type sensorSchemas struct {
+	sensors map[string]*sensorSchema
+	groups  map[string]*schemaGroup
+}
+
+// schemaCfg loads the schema file named in [sensors], by default sensors.toml
+// next to the config. YAML and JSON work too, the extension tells which one it is.
+func schemaCfg() error {
+	path := viper.GetString("sensors.schema")
+	if path == "" {
+		path = defaultSchemaFile
+	}
+	if !filepath.IsAbs(path) && viper.ConfigFileUsed() != "" {
+		path = filepath.Join(filepath.Dir(viper.ConfigFileUsed()), path)
+	}
+	cfg := viper.New()
+	cfg.SetConfigFile(path)
+	err := cfg.ReadInConfig()
+	if err != nil {
+		return err
+	}
+	loaded, err := parseSchemas(cfg)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	schemas = loaded
+	return nil
+}
+
+func parseSchemas(cfg *viper.Viper) (*sensorSchemas, error) {
+	var (
+		groups  []*schemaGroup
+		sensors []*sensorSchema
+	)
+	err := cfg.UnmarshalKey("group", &groups)
+	if err != nil {
+		return nil, err
+	}
+	err = cfg.UnmarshalKey("sensor", &sensors)
+	if err != nil {
+		return nil, err
+	}
+	ss := &sensorSchemas{
+		sensors: make(map[string]*sensorSchema),
+		groups:  make(map[string]*schemaGroup),
+	}
+	for _, g := range groups {
+		if g.Name == "" || g.Measurement == "" {
+			return nil, errors.New("group needs a name and a measurement")
+		}
+		if g.EntityTag == "" {
+			g.EntityTag = defaultEntityTag
+		}
+		if g.Split != "" && len(g.SplitTags) != 2 {
+			return nil, fmt.Errorf("group %s: split needs two split_tags", g.Name)
+		}
+		if (len(g.Watch) > 0) != (g.Events != "") {
+			return nil, fmt.Errorf("group %s: watch and events go together", g.Name)
+		}
+		ss.groups[g.Name] = g
+	}
+	for _, sn := range sensors {
+		if sn.Path == "" {
+			return nil, errors.New("sensor needs a path")
+		}
+		if _, ok := ss.sensors[sn.Path]; ok {
+			return nil, fmt.Errorf("sensor %s is described twice", sn.Path)
+		}
+		g, ok := ss.groups[sn.Group]
+		if !ok {
+			return nil, fmt.Errorf("sensor %s: unknown group %q", sn.Path, sn.Group)
+		}
+		sn.group = g
+		if sn.Entity == "" {
+			sn.Entity = defaultEntityAttr
+		}
+		err := checkFields(sn.Fields)
+		if err != nil {
+			return nil, fmt.Errorf("sensor %s: %v", sn.Path, err)
+		}
+		for _, l := range sn.Lists {
+			err := ss.checkList(l)
+			if err != nil {
+				return nil, fmt.Errorf("sensor %s: list %s: %v", sn.Path, l.Key, err)
+			}
+		}
+		ss.sensors[sn.Path] = sn
+	}
+	for _, g := range ss.groups {
+		for _, p := range g.Require {
+			if sn, ok := ss.sensors[p]; !ok || sn.group != g {
+				return nil, fmt.Errorf("group %s requires %s which is not one of its sensors", g.Name, p)
+			}
+		}
+	}
+	return ss, nil
+}
+
+// checkFields fills in default types and refuses the ones we can not convert to
+func checkFields(fields map[string]schemaField) error {
+	for key, f := range fields {
+		if f.Name == "" {
+			return fmt.Errorf("field %s needs a name", key)
+		}
+		if f.Type == "" {
+			f.Type = schemaInt
+		}
+		switch f.Type {
+		case schemaInt, schemaFloat, schemaString, schemaBool:
+		default:
+			return fmt.Errorf("field %s has unknown type %q", key, f.Type)
+		}
+		fields[key] = f
+	}
+	return nil
+}
+
+func (ss *sensorSchemas) checkList(l *schemaList) error {
+	if l.Key == "" || l.Attr == "" {
+		return errors.New("list needs a key and an attr")
+	}
+	if l.Measurement != "" && l.Group != "" {
+		return errors.New("list elements are either points of a measurement or entities of a group")
+	}
+	if l.Group != "" {
+		g, ok := ss.groups[l.Group]
+		if !ok {
+			return fmt.Errorf("unknown group %q", l.Group)
+		}
+		l.group = g
+	}
+	if l.Tag == "" {
+		l.Tag = strings.Replace(l.Attr, "-", "_", -1)
+	}
+	if l.Join == "" {
+		l.Join = "."
+	}
+	if l.Sum {
+		if l.Measurement != "" || l.Group != "" {
+			return errors.New("only elements joining the entity are summed")
+		}
+		for key, f := range l.Fields {
+			if f.Type != "" && f.Type != schemaInt && f.Type != schemaFloat {
+				return fmt.Errorf("field %s of type %s can not be summed", key, f.Type)
+			}
+		}
+	}
+	return checkFields(l.Fields)
+}
+
+// apply puts a KV into an entity, false if the schema does not know the key
+func (sn *sensorSchema) apply(e *schemaEntity, key string, kv *na_pb.KeyValue) bool {
+	return applyKV(sn.Tags, sn.Fields, e, key, "", kv)
+}
+
+// applyKV maps a key with tags and fields, their names get prefix in front.
+// A key can be both, a state is handy to filter on and to graph.
+func applyKV(tags map[string]string, fields map[string]schemaField, e *schemaEntity, key, prefix string, kv *na_pb.KeyValue) bool {
+	tag, isTag := tags[key]
+	if isTag {
+		e.Tags[prefix+tag] = kvString(kv)
+	}
+	f, ok := fields[key]
+	if !ok {
+		return isTag
+	}
+	name := prefix + f.Name
+	switch f.Type {
+	case schemaInt:
+		e.Fields[name] = kvInt(kv)
+	case schemaFloat:
+		e.Fields[name] = kvFloat(kv)
+	case schemaString:
+		e.Fields[name] = kvString(kv)
+	case schemaBool:
+		e.Fields[name] = kvBool(kv)
+	}
+	return true
+}
+
+// sumKV adds a KV to what the entity has so far, only within a walk as every walk starts over
+func sumKV(fields map[string]schemaField, e *schemaEntity, key, prefix string, kv *na_pb.KeyValue) bool {
+	f, ok := fields[key]
+	if !ok {
+		return false
+	}
+	name := prefix + f.Name
+	if f.Type == schemaFloat {
+		sum, _ := e.Fields[name].(float64)
+		e.Fields[name] = sum + kvFloat(kv)
+		return true
+	}
+	sum, _ := e.Fields[name].(int64)
+	e.Fields[name] = sum + kvInt(kv)
+	return true
+}
+
+// match splits a key of a list element into the element and the key within it,
+// both <key>[<attr>='<element>']/<leaf> and Junos' <key> [<attr>=<element>]/<leaf> are fine.
+// Elements with more than one key e.g. [fc-number='0'][if-family='inet'] are named by attr.
+func (l *schemaList) match(key string) (string, string, bool) {
+	if !strings.HasPrefix(key, l.Key) {
+		return "", "", false
+	}
+	rest := strings.TrimLeft(key[len(l.Key):], " ")
+	element, found := "", false
+	for strings.HasPrefix(rest, "[") {
+		end := strings.Index(rest, "]")
+		if end < 0 {
+			return "", "", false
+		}
+		kv := strings.SplitN(rest[1:end], "=", 2)
+		if len(kv) == 2 && strings.TrimSpace(kv[0]) == l.Attr {
+			element = strings.Trim(strings.TrimSpace(kv[1]), "'\"")
+			found = true
+		}
+		rest = rest[end+1:]
+	}
+	if !found {
+		return "", "", false
+	}
+	return element, strings.TrimLeft(rest, "/"), true
+}
+
+// forget drops what a deleted leaf was mapped to
+func (sn *sensorSchema) forget(e *schemaEntity, key string) {
+	if tag, ok := sn.Tags[key]; ok {
+		delete(e.Tags, tag)
+	}
+	if f, ok := sn.Fields[key]; ok {
+		delete(e.Fields, f.Name)
+	}
+}
+
+// scope is what prefix_tags found in a prefix, as part of the entity key and as tags
+func (sn *sensorSchema) scope(prefixVal string) (string, map[string]string) {
+	if len(sn.PrefixTags) == 0 {
+		return "", nil
+	}
+	var attrs []string
+	for attr := range sn.PrefixTags {
+		attrs = append(attrs, attr)
+	}
+	sort.Strings(attrs)
+	var parts []string
+	tags := make(map[string]string)
+	for _, attr := range attrs {
+		v, _, err := prefixSplit(prefixVal, attr)
+		if err != nil {
+			continue
+		}
+		tags[sn.PrefixTags[attr]] = v
+		parts = append(parts, attr+"="+v)
+	}
+	return strings.Join(parts, ","), tags
+}
+
+// prefixEntity finds the value of attr='...' in a __prefix__ value
+func prefixEntity(prefixVal, attr string) (string, error) {
+	name, _, err := prefixSplit(prefixVal, attr)
+	return name, err
+}
+
+// prefixSplit also returns what follows the entity, keys are relative to that
+func prefixSplit(prefixVal, attr string) (string, string, error) {
+	pattern := attr + "='"
+	// name=' is not the end of instance-name='
+	start := -1
+	for i := 0; i < len(prefixVal); {
+		j := strings.Index(prefixVal[i:], pattern)
+		if j < 0 {
+			break
+		}
+		if i+j == 0 || prefixVal[i+j-1] == '[' || prefixVal[i+j-1] == ' ' {
+			start = i + j
+			break
+		}
+		i += j + len(pattern)
+	}
+	if start < 0 {
+		return "", "", errNoEntity{pattern}
+	}
+	rest := prefixVal[start+len(pattern):]
+	end := strings.Index(rest, "']")
+	if end < 0 {
+		return "", "", errors.New("did not find \"']\" pattern to split str ")
+	}
+	return rest[:end], strings.TrimLeft(rest[end+2:], "/"), nil
+}
+
+// errNoEntity is a prefix without the entity, e.g. global BGP state in a neighbor sensor
+type errNoEntity struct {
+	pattern string
+}
+
+func (e errNoEntity) Error() string {
+	return fmt.Sprintf("did not find \"%s\" pattern to split str ", e.pattern)
+}
+
+func kvString(kv *na_pb.KeyValue) string {
+	switch v := kv.Value.(type) {
+	case *na_pb.KeyValue_StrValue:
+		return v.StrValue
+	case *na_pb.KeyValue_IntValue:
+		return strconv.FormatInt(v.IntValue, 10)
+	case *na_pb.KeyValue_UintValue:
+		return strconv.FormatUint(v.UintValue, 10)
+	case *na_pb.KeyValue_SintValue:
+		return strconv.FormatInt(v.SintValue, 10)
+	case *na_pb.KeyValue_DoubleValue:
+		return strconv.FormatFloat(v.DoubleValue, 'f', -1, 64)
+	case *na_pb.KeyValue_BoolValue:
+		return strconv.FormatBool(v.BoolValue)
+	case *na_pb.KeyValue_BytesValue:
+		return string(v.BytesValue)
+	}
+	return ""
+}
+
+func kvFloat(kv *na_pb.KeyValue) float64 {
+	switch v := kv.Value.(type) {
+	case *na_pb.KeyValue_DoubleValue:
+		return v.DoubleValue
+	case *na_pb.KeyValue_StrValue:
+		f, _ := strconv.ParseFloat(v.StrValue, 64)
+		return f
+	}
+	return float64(kvInt(kv))
+}
+
+func kvBool(kv *na_pb.KeyValue) bool {
+	if v, ok := kv.Value.(*na_pb.KeyValue_StrValue); ok {
+		b, _ := strconv.ParseBool(v.StrValue)
+		return b
+	}
+	return kv.GetBoolValue() || kvInt(kv) != 0
+}
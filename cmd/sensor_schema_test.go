@@ -0,0 +1,250 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// testSchema is small enough to tell from a failure what went wrong,
+// sensors.toml itself is only checked to load
+const testSchema = `
+[[group]]
+name = "interface"
+measurement = "phy_interface"
+entity_tag = "name"
+require = ["/lc/", "/if/"]
+
+[[sensor]]
+path = "/lc/"
+group = "interface"
+  [sensor.tags]
+  "parent_ae_name" = "ae_name"
+  [sensor.fields]
+  "counters/in-octets" = { name = "in_octets" }
+  [[sensor.list]]
+  key = "out-queue"
+  attr = "queue-number"
+  measurement = "queue_stats"
+  tag = "queue"
+    [sensor.list.fields]
+    "pkts" = { name = "pkts" }
+
+[[sensor]]
+path = "/if/"
+group = "interface"
+  [sensor.tags]
+  "state/oper-status" = "oper_state"
+  [sensor.fields]
+  "state/mtu" = { name = "mtu" }
+
+[[group]]
+name = "bgp"
+measurement = "bgp"
+entity_tag = "neighbor"
+watch = ["session_state"]
+events = "bgp_events"
+
+[[sensor]]
+path = "/bgp/"
+group = "bgp"
+entity = "neighbor-address"
+  [sensor.prefix_tags]
+  "instance-name" = "vrf"
+  [sensor.tags]
+  "state/session-state" = "session_state"
+  [sensor.fields]
+  "state/peer-as" = { name = "peer_as" }
+`
+
+func loadSchema(t *testing.T, schema string) (*sensorSchemas, error) {
+	cfg := viper.New()
+	cfg.SetConfigType("toml")
+	err := cfg.ReadConfig(strings.NewReader(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parseSchemas(cfg)
+}
+
+// useSchema swaps the schema decoders read for the rest of a test
+func useSchema(t *testing.T, schema string) {
+	loaded, err := loadSchema(t, schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	was := schemas
+	schemas = loaded
+	t.Cleanup(func() { schemas = was })
+}
+
+// useSensorsToml is useSchema with the schema we ship, for conversions
+// which have to land on the real sensor paths
+func useSensorsToml(t *testing.T) {
+	cfg := viper.New()
+	cfg.SetConfigFile(defaultSchemaFile)
+	err := cfg.ReadInConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := parseSchemas(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	was := schemas
+	schemas = loaded
+	t.Cleanup(func() { schemas = was })
+}
+
+func TestSensorsToml(t *testing.T) {
+	useSensorsToml(t)
+}
+
+func TestParseSchemas(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		err    string
+	}{
+		{"test schema", testSchema, ""},
+		{"unknown group", `
+[[sensor]]
+path = "/a/"
+group = "nope"`, `unknown group "nope"`},
+		{"required sensor of another group", `
+[[group]]
+name = "a"
+measurement = "a"
+require = ["/b/"]
+[[group]]
+name = "b"
+measurement = "b"
+[[sensor]]
+path = "/b/"
+group = "b"`, "requires /b/ which is not one of its sensors"},
+		{"field type", `
+[[group]]
+name = "a"
+measurement = "a"
+[[sensor]]
+path = "/a/"
+group = "a"
+  [sensor.fields]
+  "x" = { name = "x", type = "uint" }`, `unknown type "uint"`},
+		{"list of a measurement and a group", `
+[[group]]
+name = "a"
+measurement = "a"
+[[sensor]]
+path = "/a/"
+group = "a"
+  [[sensor.list]]
+  key = "q"
+  attr = "n"
+  measurement = "q"
+  group = "a"`, "either points of a measurement or entities of a group"},
+		{"summed string", `
+[[group]]
+name = "a"
+measurement = "a"
+[[sensor]]
+path = "/a/"
+group = "a"
+  [[sensor.list]]
+  key = "q"
+  attr = "n"
+  sum = true
+    [sensor.list.fields]
+    "x" = { name = "x", type = "string" }`, "can not be summed"},
+		{"summed elements of a measurement", `
+[[group]]
+name = "a"
+measurement = "a"
+[[sensor]]
+path = "/a/"
+group = "a"
+  [[sensor.list]]
+  key = "q"
+  attr = "n"
+  measurement = "q"
+  sum = true`, "only elements joining the entity are summed"},
+		{"watch without events", `
+[[group]]
+name = "a"
+measurement = "a"
+watch = ["x"]`, "watch and events go together"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadSchema(t, tt.schema)
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatalf("unexpected error %v", err)
+			case tt.err != "" && err == nil:
+				t.Fatalf("no error, want %q", tt.err)
+			case tt.err != "" && !strings.Contains(err.Error(), tt.err):
+				t.Fatalf("error %q, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestPrefixSplit(t *testing.T) {
+	tests := []struct {
+		prefix string
+		attr   string
+		name   string
+		rest   string
+		err    bool
+		none   bool
+	}{
+		{"/interfaces/interface[name='xe-0/0/0']/", "name", "xe-0/0/0", "", false, false},
+		{"/interfaces/interface[name='xe-0/0/0']/subinterfaces/", "name", "xe-0/0/0", "subinterfaces/", false, false},
+		// instance-name=' must not be taken for name='
+		{"/network-instances/network-instance[instance-name='master']/protocols/protocol[name='BGP']/", "name", "BGP", "", false, false},
+		{"/network-instances/network-instance[instance-name='master']/neighbor[neighbor-address='10.0.0.2']/", "instance-name", "master", "neighbor[neighbor-address='10.0.0.2']/", false, false},
+		{"/interfaces/interface[name='xe-0/0/0", "name", "", "", true, false},
+		{"/network-instances/network-instance[instance-name='master']/", "neighbor-address", "", "", true, true},
+	}
+	for _, tt := range tests {
+		name, rest, err := prefixSplit(tt.prefix, tt.attr)
+		if (err != nil) != tt.err {
+			t.Errorf("%s %s: error %v", tt.prefix, tt.attr, err)
+			continue
+		}
+		if _, none := err.(errNoEntity); none != tt.none {
+			t.Errorf("%s %s: error %v, no entity %v", tt.prefix, tt.attr, err, tt.none)
+		}
+		if name != tt.name || rest != tt.rest {
+			t.Errorf("%s %s: got %q %q, want %q %q", tt.prefix, tt.attr, name, rest, tt.name, tt.rest)
+		}
+	}
+}
+
+func TestListMatch(t *testing.T) {
+	l := &schemaList{Key: "out-queue", Attr: "queue-number"}
+	multi := &schemaList{Key: "fc", Attr: "fc-number"}
+	tests := []struct {
+		list    *schemaList
+		key     string
+		element string
+		leaf    string
+		ok      bool
+	}{
+		{l, "out-queue[queue-number='0']/pkts", "0", "pkts", true},
+		{l, "out-queue [queue-number=3]/red-drop-pkts", "3", "red-drop-pkts", true},
+		{l, `out-queue[queue-number="7"]/bytes`, "7", "bytes", true},
+		{multi, "fc[fc-number='1'][if-family='inet']/pkts", "1", "pkts", true},
+		{multi, "fc[if-family='inet'][fc-number='2']/pkts", "2", "pkts", true},
+		{l, "out-queue[name='0']/pkts", "", "", false},
+		{l, "out-queue[queue-number='0'/pkts", "", "", false},
+		{l, "in-queue[queue-number='0']/pkts", "", "", false},
+	}
+	for _, tt := range tests {
+		element, leaf, ok := tt.list.match(tt.key)
+		if element != tt.element || leaf != tt.leaf || ok != tt.ok {
+			t.Errorf("%s: got %q %q %v, want %q %q %v", tt.key, element, leaf, ok, tt.element, tt.leaf, tt.ok)
+		}
+	}
+}
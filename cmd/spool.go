@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"sync"
+	"time"
+
+	bolt "github.com/coreos/bbolt"
+)
+
+const (
+	// batches replayed in one go, new data goes to the spool meanwhile
+	// so a long backlog does not hold up decoding
+	spoolReplayBatches = 10
+	spoolOpenTimeout   = time.Second
+)
+
+var spoolBucket = []byte("batches")
+
+// spool keeps batches an output failed to write in a bbolt file, keys are
+// sequence numbers so batches come back in the order they were written.
+// It is bounded in size, the oldest batches are dropped to make room.
+type spool struct {
+	db       *bolt.DB
+	maxBytes int64
+	sync.Mutex
+	bytes   int64
+	batches int64
+	records int64
+	// records thrown away because the spool was full
+	drops int64
+}
+
+// spooledBatch carries the time it was queued to tell how far behind an output is
+type spooledBatch struct {
+	Queued  time.Time
+	Records []Record
+}
+
+func openSpool(path string, maxBytes int64) (*spool, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: spoolOpenTimeout})
+	if err != nil {
+		return nil, err
+	}
+	s := &spool{db: db, maxBytes: maxBytes}
+	// whatever was left over from the last run is replayed as well
+	err = db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(spoolBucket)
+		if err != nil {
+			return err
+		}
+		return b.ForEach(func(k, v []byte) error {
+			batch, err := decodeBatch(v)
+			if err != nil {
+				return err
+			}
+			s.bytes += int64(len(v))
+			s.batches++
+			s.records += int64(len(batch.Records))
+			return nil
+		})
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func encodeBatch(batch *spooledBatch) ([]byte, error) {
+	var b bytes.Buffer
+	err := gob.NewEncoder(&b).Encode(batch)
+	return b.Bytes(), err
+}
+
+// gob keeps int64 fields int64, JSON would turn them into floats
+// which backends such as Influx treat as a different field type
+func decodeBatch(v []byte) (*spooledBatch, error) {
+	var batch spooledBatch
+	err := gob.NewDecoder(bytes.NewReader(v)).Decode(&batch)
+	return &batch, err
+}
+
+// push stores a batch, making room by dropping the oldest ones
+func (s *spool) push(recs []Record) error {
+	v, err := encodeBatch(&spooledBatch{Queued: time.Now(), Records: recs})
+	if err != nil {
+		return err
+	}
+	s.Lock()
+	defer s.Unlock()
+	if int64(len(v)) > s.maxBytes {
+		s.drops += int64(len(recs))
+		return errors.New(spoolTooBigEv)
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(spoolBucket)
+		// deleting while moving a cursor skips entries, so first find what goes
+		var evict [][]byte
+		freed := int64(0)
+		c := b.Cursor()
+		for k, old := c.First(); k != nil && s.bytes-freed+int64(len(v)) > s.maxBytes; k, old = c.Next() {
+			if batch, err := decodeBatch(old); err == nil {
+				s.records -= int64(len(batch.Records))
+				s.drops += int64(len(batch.Records))
+			}
+			freed += int64(len(old))
+			s.batches--
+			evict = append(evict, append([]byte{}, k...))
+		}
+		s.bytes -= freed
+		for _, k := range evict {
+			err := b.Delete(k)
+			if err != nil {
+				return err
+			}
+		}
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		s.bytes += int64(len(v))
+		s.batches++
+		s.records += int64(len(recs))
+		return b.Put(spoolKey(seq), v)
+	})
+}
+
+func spoolKey(seq uint64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, seq)
+	return k
+}
+
+// oldest returns the batch to replay next, nil once the spool is empty
+func (s *spool) oldest() ([]byte, *spooledBatch, error) {
+	var (
+		key   []byte
+		batch *spooledBatch
+	)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		k, v := tx.Bucket(spoolBucket).Cursor().First()
+		if k == nil {
+			return nil
+		}
+		key = append([]byte{}, k...)
+		var err error
+		batch, err = decodeBatch(v)
+		return err
+	})
+	return key, batch, err
+}
+
+// remove deletes a batch once it is written or known to be undeliverable
+func (s *spool) remove(key []byte) error {
+	s.Lock()
+	defer s.Unlock()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(spoolBucket)
+		v := b.Get(key)
+		if v == nil {
+			return nil
+		}
+		if batch, err := decodeBatch(v); err == nil {
+			s.records -= int64(len(batch.Records))
+		}
+		s.bytes -= int64(len(v))
+		s.batches--
+		return b.Delete(key)
+	})
+}
+
+func (s *spool) empty() bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.batches == 0
+}
+
+// stats are depth, age of the oldest batch and drops, reported with output stats
+func (s *spool) stats(now time.Time) map[string]interface{} {
+	s.Lock()
+	st := map[string]interface{}{
+		"spool_batches": s.batches,
+		"spool_records": s.records,
+		"spool_bytes":   s.bytes,
+		"spool_drops":   s.drops,
+	}
+	s.Unlock()
+	var age time.Duration
+	_, batch, err := s.oldest()
+	if err == nil && batch != nil {
+		age = now.Sub(batch.Queued)
+	}
+	st["spool_age_s"] = age.Seconds()
+	return st
+}
+
+func (s *spool) close() error {
+	return s.db.Close()
+}
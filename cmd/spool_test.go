@@ -0,0 +1,141 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func spoolBatch(n int64) []Record {
+	return []Record{{
+		Measurement: metricPhyIf,
+		Tags:        map[string]string{"host": "r1", "name": "xe-0/0/0"},
+		Fields:      map[string]interface{}{"in_octets": n},
+		Timestamp:   time.Unix(n, 0),
+	}}
+}
+
+// batchSize is what a batch of spoolBatch takes in the spool
+func batchSize(t *testing.T) int64 {
+	v, err := encodeBatch(&spooledBatch{Queued: time.Now(), Records: spoolBatch(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return int64(len(v))
+}
+
+// replay takes every batch out of the spool oldest first
+func replay(t *testing.T, s *spool) []int64 {
+	var got []int64
+	for {
+		key, batch, err := s.oldest()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if batch == nil {
+			return got
+		}
+		// gob must not turn counters into another type
+		got = append(got, batch.Records[0].Fields["in_octets"].(int64))
+		err = s.remove(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestSpoolReplayOrder(t *testing.T) {
+	s, err := openSpool(filepath.Join(t.TempDir(), "spool.db"), 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.close()
+	for n := int64(1); n <= 3; n++ {
+		err := s.push(spoolBatch(n))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	got := replay(t, s)
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Fatalf("replayed %v, want [1 2 3]", got)
+	}
+	if !s.empty() {
+		t.Fatal("spool is not empty after replay")
+	}
+	st := s.stats(time.Now())
+	if st["spool_bytes"].(int64) != 0 || st["spool_records"].(int64) != 0 {
+		t.Fatalf("stats of an empty spool %v", st)
+	}
+}
+
+func TestSpoolEvictsOldest(t *testing.T) {
+	// room for two batches and a bit, not three
+	s, err := openSpool(filepath.Join(t.TempDir(), "spool.db"), 2*batchSize(t)+batchSize(t)/2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.close()
+	for n := int64(1); n <= 4; n++ {
+		err := s.push(spoolBatch(n))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	st := s.stats(time.Now())
+	if st["spool_batches"].(int64) != 2 || st["spool_drops"].(int64) != 2 {
+		t.Fatalf("stats %v, want 2 batches and 2 drops", st)
+	}
+	got := replay(t, s)
+	if len(got) != 2 || got[0] != 3 || got[1] != 4 {
+		t.Fatalf("replayed %v, want [3 4]", got)
+	}
+}
+
+func TestSpoolTooBig(t *testing.T) {
+	s, err := openSpool(filepath.Join(t.TempDir(), "spool.db"), batchSize(t)/2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.close()
+	err = s.push(spoolBatch(1))
+	if err == nil {
+		t.Fatal("a batch bigger than the spool was taken")
+	}
+	if !s.empty() || s.stats(time.Now())["spool_drops"].(int64) != 1 {
+		t.Fatalf("stats %v, want an empty spool and 1 drop", s.stats(time.Now()))
+	}
+}
+
+func TestSpoolReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spool.db")
+	s, err := openSpool(path, 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for n := int64(1); n <= 2; n++ {
+		err := s.push(spoolBatch(n))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.close()
+	s, err = openSpool(path, 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.close()
+	st := s.stats(time.Now())
+	if st["spool_batches"].(int64) != 2 || st["spool_records"].(int64) != 2 {
+		t.Fatalf("stats after reopen %v, want 2 batches and 2 records", st)
+	}
+	// new batches go after the ones left over
+	err = s.push(spoolBatch(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := replay(t, s)
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Fatalf("replayed %v, want [1 2 3]", got)
+	}
+}
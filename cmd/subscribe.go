@@ -4,13 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"os"
-	"os/signal"
 	"strings"
-	"time"
 
+	"sticoll/rest"
 	na_pb "sticoll/telemetry"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -24,32 +23,27 @@ import (
 // to the same interface even within one OC data packet.
 // Also, data types do not contain all the fields one would reasonably want.
 // As a result, I have to collect information about single interface from 3 or 4 data sets.
-// You can imagine how complex this can get as I have to use multiple flags
-// to track which data types have already been collected.
-func (d *device) subSendAndReceive(client na_pb.OpenConfigTelemetry_TelemetrySubscribeClient) {
-	ifStats := newinterfaceStats(d.ifxPointCh)
-	go func() {
-		sigchan := make(chan os.Signal, 10)
-		signal.Notify(sigchan, os.Interrupt)
-		<-sigchan
-		err := client.CloseSend()
-		if err != nil {
-			logErrEvent(grpcTopic, eventCloseSendErr, err)
-		}
-		os.Exit(0)
-	}()
+// Each sensor path is collected until its End-of-Message marker and only then
+// merged with the other data sets, see schemaWalk.
+func (d *device) subSendAndReceive(client na_pb.OpenConfigTelemetry_TelemetrySubscribeClient) error {
+	ifStats := newinterfaceStats(d.pointCh, d.classes)
 	logInfoEvent(grpcTopic, "subscribed and waiting for new data", fmt.Sprintf("hostname: %s port: %d", d.cfg.Host, d.cfg.Port))
 	for {
 		ocData, err := client.Recv()
 		// fmt.Println("RRRRRRRRRRRRRRR", ocData)
 		if err == io.EOF {
-			break
+			return errors.New(grpcEOFEv)
 		}
 		if err != nil {
-			logErrEvent(grpcTopic, eventRecvErr, err)
-			time.Sleep(1 * time.Minute)
+			return fmt.Errorf("%s: %v", eventRecvErr, err)
 		}
 		if ocData != nil {
+			d.Stats.received(seqKey{
+				systemID:       ocData.SystemId,
+				componentID:    ocData.ComponentId,
+				subComponentID: ocData.SubComponentId,
+				path:           ocData.Path,
+			}, ocData.SequenceNumber, len(ocData.Kv))
 			if ocData.SyncResponse {
 				logInfoEvent(grpcTopic, eventSyncRespRecv, "")
 			}
@@ -59,57 +53,59 @@ func (d *device) subSendAndReceive(client na_pb.OpenConfigTelemetry_TelemetrySub
 			if len(splitPath) >= 4 {
 				dataType = splitPath[2]
 			}
+			d.Stats.sample(dataType, ocData.Timestamp)
 			// Now a path turns into a data type so it can be handeled differently
 			// for _, keve := range ocData.Kv {
 			// 	fmt.Printf("Path: %s key is %s and value is %s\n", dataType, keve.Key, keve.Value)
 			// }
-			switch dataType {
-			case linecardPhyIf:
-				ifStats.linecardPhyIfStats(ocData, d.cfg.Host)
-			case linecardLogicIF:
-			case interfaces:
-				ifStats.interfaceState(ocData, d.cfg.Host)
+			// sensors.toml tells how each sensor is decoded
+			if _, ok := schemas.sensors[dataType]; ok && len(ocData.Delete) > 0 {
+				ifStats.deletes(dataType, ocData, d.cfg.Host)
 			}
+			if dataType == qos {
+				ifStats.qos(ocData)
+			}
+			ifStats.decode(dataType, ocData, d.cfg.Host)
 		}
 	}
 }
 
-func (d *device) subscribe(conn *grpc.ClientConn) {
+func (d *device) subscribe(ctx context.Context, conn *grpc.ClientConn) error {
 	if conn == nil {
-		logErrEvent(grpcTopic, grpcSendErrEv, errors.New(eventGRPCConnErr))
-		return
+		return errors.New(eventGRPCConnErr)
 	}
 	var (
 		sR    na_pb.SubscriptionRequest
 		adCfg na_pb.SubscriptionAdditionalConfig
-		ctx   context.Context
 	)
 	adCfg.NeedEos = d.cfg.EOS
+	// with collectors the device streams there rather than back on this connection
+	if len(d.cfg.Collectors) > 0 {
+		sR.Input = &na_pb.SubscriptionInput{}
+		for _, c := range d.cfg.Collectors {
+			sR.Input.CollectorList = append(sR.Input.CollectorList, &na_pb.Collector{
+				Address: c.Address,
+				Port:    c.Port,
+			})
+		}
+	}
 	for _, p := range d.cfg.Paths {
-		sR.PathList = append(sR.PathList, &na_pb.Path{
-			Path:            p.Path,
-			SampleFrequency: uint32(p.Freq),
-		})
+		sR.PathList = append(sR.PathList, subPath(p))
 	}
 	sR.AdditionalConfig = &adCfg
 	c := na_pb.NewOpenConfigTelemetryClient(conn)
-	if d.cfg.Meta {
-		md := metadata.New(map[string]string{
-			"username": d.cfg.User,
-			"password": d.cfg.Password,
-		})
-		ctx = metadata.NewOutgoingContext(context.Background(), md)
-	} else {
-		ctx = context.Background()
-	}
-	subClient, err := c.TelemetrySubscribe(ctx, &sR)
+	// the stream outlives ctx a little so it can be closed politely on stop
+	streamCtx, streamCancel := context.WithCancel(context.Background())
+	defer streamCancel()
+	subClient, err := c.TelemetrySubscribe(d.metaCtx(streamCtx), &sR)
 	if err != nil {
-		logFatal(grpcTopic, grpcSendErrEv, err)
+		return fmt.Errorf("%s: %v", grpcSendErrEv, err)
 	}
 	hdr, err := subClient.Header()
 	if err != nil {
-		logFatal(grpcTopic, grpcHeaderErrEv, err)
+		return fmt.Errorf("%s: %v", grpcHeaderErrEv, err)
 	}
+	d.transition(stateSubscribed)
 	var headers string
 	for k, v := range hdr {
 		headers = headers + fmt.Sprintf("%s: %s", k, v)
@@ -118,5 +114,89 @@ func (d *device) subscribe(conn *grpc.ClientConn) {
 		"topic":   grpcTopic,
 		"headers": headers,
 	}).Info("headers list")
-	d.subSendAndReceive(subClient)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		select {
+		case <-ctx.Done():
+		case <-done:
+			return
+		}
+		// the device may keep the subscription around unless told otherwise,
+		// so when we stop on purpose tell it before dropping the stream
+		err := subClient.CloseSend()
+		if err != nil {
+			logErrEvent(grpcTopic, eventCloseSendErr, err)
+		}
+		d.cancelSubscription(c, subscriptionID(hdr))
+		streamCancel()
+	}()
+	err = d.subSendAndReceive(subClient)
+	// the connection has to stay up until the cancel went through
+	close(done)
+	<-stopped
+	return err
+}
+
+// metaCtx adds credentials to RPC metadata for devices logging in that way
+func (d *device) metaCtx(ctx context.Context) context.Context {
+	if !d.cfg.Meta {
+		return ctx
+	}
+	md := metadata.New(map[string]string{
+		"username": d.cfg.User,
+		"password": d.cfg.Password,
+	})
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// subscriptionID is found in the init-response header which Junos sends
+// as a SubscriptionReply in text format
+func subscriptionID(hdr metadata.MD) uint32 {
+	v := hdr.Get("init-response")
+	if len(v) == 0 {
+		return 0
+	}
+	var reply na_pb.SubscriptionReply
+	err := proto.UnmarshalText(v[0], &reply)
+	if err != nil {
+		logErrEvent(grpcTopic, grpcSubIDErrEv, err)
+		return 0
+	}
+	return reply.GetResponse().GetSubscriptionId()
+}
+
+func (d *device) cancelSubscription(c na_pb.OpenConfigTelemetryClient, id uint32) {
+	if id == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), cancelTimeout)
+	defer cancel()
+	reply, err := c.CancelTelemetrySubscription(d.metaCtx(ctx), &na_pb.CancelSubscriptionRequest{
+		SubscriptionId: id,
+	})
+	if err != nil {
+		logErrEvent(grpcTopic, grpcCancelErrEv, err)
+		return
+	}
+	logInfoEvent(grpcTopic, "subscription cancelled", fmt.Sprintf("hostname: %s id: %d reply: %s", d.cfg.Host, id, reply.GetCodeStr()))
+}
+
+// subPath translates a configured path into the request, Junos has no explicit
+// on change flag, a zero sample frequency is what asks for it.
+// EOM is always requested as entities are only complete once a walk is over.
+func subPath(p rest.Spath) *na_pb.Path {
+	path := &na_pb.Path{
+		Path:              p.Path,
+		Filter:            p.Filter,
+		SuppressUnchanged: p.SuppressUnchanged,
+		MaxSilentInterval: uint32(p.MaxSilentInterval),
+		SampleFrequency:   uint32(p.Freq),
+		NeedEom:           true,
+	}
+	if p.Mode == rest.ModeOnChange {
+		path.SampleFrequency = 0
+	}
+	return path
 }
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	tsDevice         = "device"
+	tsCollector      = "collector"
+	defaultTsMaxSkew = 5 * time.Minute
+	// a router with a wrong clock would log on every point otherwise
+	tsSkewLogEvery = time.Minute
+)
+
+// set once from the [timestamp] section on start
+var (
+	tsSource  = tsDevice
+	tsMaxSkew = defaultTsMaxSkew
+	// unix nanoseconds of the last skew warning
+	tsSkewLogged int64
+)
+
+func timestampCfg() error {
+	if src := viper.GetString("timestamp.source"); src != "" {
+		if src != tsDevice && src != tsCollector {
+			return fmt.Errorf("timestamp source %q, use %s or %s", src, tsDevice, tsCollector)
+		}
+		tsSource = src
+	}
+	if d := viper.GetDuration("timestamp.max_skew"); d > 0 {
+		tsMaxSkew = d
+	}
+	return nil
+}
+
+// pointTime is the timestamp a point gets, the one the device put into the data
+// unless told otherwise. Rates computed from device timestamps do not jitter
+// with our receive and decode delays, but a router clock off by more than
+// the allowed skew is not to be trusted and the collector time is used instead.
+// Decoders call it as they read the device time, later on points wait in queues.
+func pointTime(device time.Time) time.Time {
+	now := time.Now()
+	if tsSource == tsCollector || device.IsZero() {
+		return now
+	}
+	skew := now.Sub(device)
+	if skew > tsMaxSkew || skew < -tsMaxSkew {
+		last := atomic.LoadInt64(&tsSkewLogged)
+		if now.UnixNano()-last > int64(tsSkewLogEvery) && atomic.CompareAndSwapInt64(&tsSkewLogged, last, now.UnixNano()) {
+			logInfoEvent(tsTopic, tsSkewEv, fmt.Sprintf("device time %s is %s off", device.Format(time.RFC3339), skew))
+		}
+		return now
+	}
+	return device
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	na_pb "sticoll/telemetry"
+)
+
+func TestPointTime(t *testing.T) {
+	defer func(src string) { tsSource = src }(tsSource)
+	device := time.Now().Add(-time.Second).Truncate(time.Millisecond)
+	tests := []struct {
+		name      string
+		source    string
+		device    time.Time
+		collector bool
+	}{
+		{"device", tsDevice, device, false},
+		{"skewed device", tsDevice, device.Add(-2 * defaultTsMaxSkew), true},
+		{"ahead of us", tsDevice, device.Add(2 * defaultTsMaxSkew), true},
+		{"no device time", tsDevice, time.Time{}, true},
+		{"collector", tsCollector, device, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tsSource = tt.source
+			before := time.Now()
+			got := pointTime(tt.device)
+			if tt.collector && (got.Before(before) || got.After(time.Now())) {
+				t.Errorf("got %v, want the collector time", got)
+			}
+			if !tt.collector && !got.Equal(tt.device) {
+				t.Errorf("got %v, want %v", got, tt.device)
+			}
+		})
+	}
+}
+
+func TestDecodeCollectorTime(t *testing.T) {
+	defer func(src string) { tsSource = src }(tsSource)
+	tsSource = tsCollector
+	useSchema(t, testSchema)
+	ch := make(chan dataPoint, 16)
+	s := newinterfaceStats(ch, nil)
+	before := time.Now()
+	s.decode("/lc/", &na_pb.OpenConfigData{
+		Timestamp: 1,
+		Kv:        []*na_pb.KeyValue{kvStr("__prefix__", xe0), kvUint("counters/in-octets", 100)},
+		Eom:       []*na_pb.Eom{{}},
+	}, "r1")
+	s.decode("/if/", &na_pb.OpenConfigData{
+		Timestamp: 2,
+		Kv:        []*na_pb.KeyValue{kvStr("__prefix__", xe0), kvStr("state/oper-status", "UP"), kvUint("state/mtu", 1514)},
+		Eom:       []*na_pb.Eom{{}},
+	}, "r1")
+	decoded := time.Now()
+	// points keep the time they were decoded at, not the time outputs take them
+	time.Sleep(10 * time.Millisecond)
+	if len(ch) == 0 {
+		t.Fatal("no points")
+	}
+	for len(ch) > 0 {
+		r := (<-ch).Record()
+		if r.Timestamp.Before(before) || r.Timestamp.After(decoded) {
+			t.Errorf("%s at %v, decoded between %v and %v", r.Measurement, r.Timestamp, before, decoded)
+		}
+	}
+}
@@ -0,0 +1,386 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	jti_pb "sticoll/jti"
+	"sticoll/rest"
+	na_pb "sticoll/telemetry"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/viper"
+)
+
+// maxUDPPacket is the biggest datagram Junos exports, one sensor record per packet
+const maxUDPPacket = 64 * 1024
+
+// Junos native sensors are exported by line cards straight from the PFE as UDP datagrams.
+// Every datagram is a TelemetryStream with the actual data sitting in a Juniper extension,
+// unlike OpenConfig gRPC there is no KV flattening so records map onto our structs directly.
+type udpSrv struct {
+	Addr       string
+	Port       string
+	ReadBuffer int
+	pointCh    chan dataPoint
+	devices    *deviceRegistry
+	// per source address state, only the read loop touches these
+	sources map[string]*udpSource
+	mu      sync.Mutex
+	conn    *net.UDPConn
+	closing bool
+	done    chan struct{}
+}
+
+type udpSource struct {
+	host    string
+	dev     *device
+	known   bool
+	ifStats *interfaceStats
+	// registry generation the match was made at
+	gen uint64
+}
+
+func newUDPSrv(devices *deviceRegistry, pointCh chan dataPoint) *udpSrv {
+	return &udpSrv{
+		Addr:       viper.GetString("udp.address"),
+		Port:       viper.GetString("udp.port"),
+		ReadBuffer: viper.GetInt("udp.read_buffer"),
+		pointCh:    pointCh,
+		devices:    devices,
+		sources:    make(map[string]*udpSource),
+		done:       make(chan struct{}),
+	}
+}
+
+// startUDP blocks reading datagrams until the socket fails or stop is called
+func (s *udpSrv) startUDP() error {
+	defer close(s.done)
+	addr, err := net.ResolveUDPAddr("udp", s.Addr+":"+s.Port)
+	if err != nil {
+		return err
+	}
+	conn, err := net.ListenUDP("udp", addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	s.mu.Lock()
+	if s.closing {
+		s.mu.Unlock()
+		return nil
+	}
+	s.conn = conn
+	s.mu.Unlock()
+	if s.ReadBuffer > 0 {
+		err = conn.SetReadBuffer(s.ReadBuffer)
+		if err != nil {
+			logErrEvent(udpTopic, udpBufErrEv, err)
+		}
+	}
+	logInfoEvent(udpTopic, "listening", conn.LocalAddr().String())
+	buf := make([]byte, maxUDPPacket)
+	for {
+		n, src, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			s.mu.Lock()
+			closing := s.closing
+			s.mu.Unlock()
+			if closing {
+				return nil
+			}
+			return err
+		}
+		var ts jti_pb.TelemetryStream
+		err = proto.Unmarshal(buf[:n], &ts)
+		if err != nil {
+			if known, ok := s.sources[src.IP.String()]; ok {
+				known.dev.Stats.decodeErr()
+			}
+			logErrEvent(udpTopic, udpDecodeErrEv, err)
+			continue
+		}
+		source := s.source(src.IP.String(), &ts)
+		source.dev.Stats.inBytes(n)
+		source.dev.Stats.received(seqKey{
+			systemID:       ts.GetSystemId(),
+			componentID:    ts.GetComponentId(),
+			subComponentID: ts.GetSubComponentId(),
+			path:           ts.GetSensorName(),
+		}, uint64(ts.GetSequenceNumber()), 0)
+		source.dev.Stats.sample(ts.GetSensorName(), ts.GetTimestamp())
+		s.handleStream(source, &ts)
+	}
+}
+
+// stop closes the socket and waits for the read loop to finish the datagram in hand
+func (s *udpSrv) stop() {
+	s.mu.Lock()
+	s.closing = true
+	conn := s.conn
+	s.mu.Unlock()
+	if conn == nil {
+		return
+	}
+	conn.Close()
+	<-s.done
+}
+
+// source finds which device sent a datagram, results are cached per address
+// as a name lookup for every datagram would be way too slow.
+// A change of devices through the API makes every address be looked up again.
+// Devices which are not configured are still accepted, they are named after system_id.
+func (s *udpSrv) source(addr string, ts *jti_pb.TelemetryStream) *udpSource {
+	gen := s.devices.generation()
+	src, ok := s.sources[addr]
+	if ok && src.gen == gen {
+		return src
+	}
+	d := s.devices.byAddr(addr)
+	host := ""
+	if d != nil {
+		host = d.config().Host
+	}
+	// still the same device, what we know about its interfaces stays
+	if ok && (d == nil && !src.known || d != nil && d == src.dev && host == src.host) {
+		src.gen = gen
+		return src
+	}
+	src = &udpSource{
+		ifStats: newinterfaceStats(s.pointCh, nil),
+		gen:     gen,
+	}
+	if d != nil {
+		src.host = host
+		src.dev = d
+		src.known = true
+	} else {
+		// system_id is router-name:export-ip
+		src.host = strings.Split(ts.GetSystemId(), ":")[0]
+		// stats are still kept but nobody reports them
+		src.dev = &device{cfg: &rest.GRPCCfg{Host: src.host}}
+		logInfoEvent(udpTopic, udpUnknownSrcEv, addr+" naming it "+src.host)
+	}
+	s.sources[addr] = src
+	return src
+}
+
+func (s *udpSrv) handleStream(src *udpSource, ts *jti_pb.TelemetryStream) {
+	if ts.Enterprise == nil {
+		return
+	}
+	ext, err := proto.GetExtension(ts.Enterprise, jti_pb.E_JuniperNetworks)
+	if err != nil {
+		src.dev.Stats.decodeErr()
+		logErrEvent(udpTopic, udpDecodeErrEv, err)
+		return
+	}
+	jnpr, ok := ext.(*jti_pb.JuniperNetworksSensors)
+	if !ok {
+		src.dev.Stats.decodeErr()
+		logErrEvent(udpTopic, udpDecodeErrEv, errors.New("enterprise extension is not JuniperNetworksSensors"))
+		return
+	}
+	timestamp := pointTime(time.Unix(0, int64(ts.GetTimestamp())*1000000))
+	if proto.HasExtension(jnpr, jti_pb.E_JnprInterfaceExt) {
+		ext, err := proto.GetExtension(jnpr, jti_pb.E_JnprInterfaceExt)
+		if err == nil {
+			src.ifStats.udpPhyIfStats(ext.(*jti_pb.GPort), src.host, timestamp)
+		}
+	}
+	if proto.HasExtension(jnpr, jti_pb.E_JnprLogicalInterfaceExt) {
+		ext, err := proto.GetExtension(jnpr, jti_pb.E_JnprLogicalInterfaceExt)
+		if err == nil {
+			src.ifStats.udpLogicalIfStats(ext.(*jti_pb.LogicalPort), src.host, timestamp)
+		}
+	}
+	if proto.HasExtension(jnpr, jti_pb.E_JnprFirewallExt) {
+		ext, err := proto.GetExtension(jnpr, jti_pb.E_JnprFirewallExt)
+		if err == nil {
+			s.udpFirewallStats(ext.(*jti_pb.Firewall), src.host, timestamp)
+		}
+	}
+	if proto.HasExtension(jnpr, jti_pb.E_JnprLspStatisticsExt) {
+		ext, err := proto.GetExtension(jnpr, jti_pb.E_JnprLspStatisticsExt)
+		if err == nil {
+			s.udpLspStats(ext.(*jti_pb.LspStats), src.host, timestamp)
+		}
+	}
+}
+
+// udpPhyIfStats turns the port sensor into KVs of the linecard and /interfaces/ sensors,
+// so sensors.toml decodes them and UDP points look the same as gRPC ones.
+// A datagram has all there is about its interfaces, it is a walk of its own.
+func (s *interfaceStats) udpPhyIfStats(port *jti_pb.GPort, hostname string, timestamp time.Time) {
+	ms := uint64(timestamp.UnixNano() / int64(time.Millisecond))
+	state := &na_pb.OpenConfigData{Path: interfaces, Timestamp: ms}
+	linecard := &na_pb.OpenConfigData{Path: linecardPhyIf, Timestamp: ms}
+	for _, info := range port.InterfaceStats {
+		name := info.GetIfName()
+		if name == "" {
+			continue
+		}
+		state.Kv = append(state.Kv,
+			strKV("__prefix__", fmt.Sprintf("/interfaces/interface[name='%s']/", name)),
+			strKV("state/description", info.GetIfDescription()),
+			strKV("state/admin-status", info.GetIfAdministrationStatus()),
+		)
+		linecard.Kv = append(linecard.Kv,
+			strKV("__prefix__", fmt.Sprintf("/junos/system/linecard/interface[name='%s']/", name)),
+			strKV("parent_ae_name", info.GetParentAeName()),
+			strKV("oper-status", info.GetIfOperationalStatus()),
+			uintKV("carrier-transitions", info.GetIfTransitions()),
+			uintKV("last-change", uint64(info.GetIfLastChange())),
+			uintKV("high-speed", uint64(info.GetIfHighSpeed())),
+		)
+		if in := info.GetIngressStats(); in != nil {
+			linecard.Kv = append(linecard.Kv,
+				uintKV("counters/in-octets", in.GetIfOctets()),
+				uintKV("counters/in-unicast-pkts", in.GetIfUcPkts()),
+				uintKV("counters/in-multicast-pkts", in.GetIfMcPkts()),
+				uintKV("counters/in-broadcast-pkts", in.GetIfBcPkts()),
+			)
+		}
+		if out := info.GetEgressStats(); out != nil {
+			linecard.Kv = append(linecard.Kv,
+				uintKV("counters/out-octets", out.GetIfOctets()),
+				uintKV("counters/out-unicast-pkts", out.GetIfUcPkts()),
+				uintKV("counters/out-multicast-pkts", out.GetIfMcPkts()),
+				uintKV("counters/out-broadcast-pkts", out.GetIfBcPkts()),
+			)
+		}
+		if inErr := info.GetIngressErrors(); inErr != nil {
+			linecard.Kv = append(linecard.Kv, uintKV("counters/in-errors", inErr.GetIfErrors()))
+		}
+	}
+	// state first, the linecard walk then has everything the group requires
+	s.decode(interfaces, state, hostname)
+	s.decode(linecardPhyIf, linecard, hostname)
+}
+
+func strKV(key, value string) *na_pb.KeyValue {
+	return &na_pb.KeyValue{Key: key, Value: &na_pb.KeyValue_StrValue{StrValue: value}}
+}
+
+func uintKV(key string, value uint64) *na_pb.KeyValue {
+	return &na_pb.KeyValue{Key: key, Value: &na_pb.KeyValue_UintValue{UintValue: value}}
+}
+
+// udpLogicalIfStats turns the logical port sensor into KVs of its gRPC sensor,
+// UDP units then have the same tags, family counters and queues as gRPC ones
+func (s *interfaceStats) udpLogicalIfStats(lp *jti_pb.LogicalPort, hostname string, timestamp time.Time) {
+	ms := uint64(timestamp.UnixNano() / int64(time.Millisecond))
+	usage := &na_pb.OpenConfigData{Path: linecardLogicalIf, Timestamp: ms}
+	for _, info := range lp.InterfaceInfo {
+		name := info.GetIfName()
+		if name == "" {
+			continue
+		}
+		usage.Kv = append(usage.Kv,
+			strKV("__prefix__", fmt.Sprintf("/junos/system/linecard/interface/logical/usage[name='%s']/", name)),
+			strKV("parent-ae-name", info.GetParentAeName()),
+			strKV("op-state/operational-status", info.GetOpState().GetOperationalStatus()),
+			strKV("administrative-status", info.GetAdministrativeStatus()),
+			strKV("description", info.GetDescription()),
+			uintKV("snmp-if-index", uint64(info.GetSnmpIfIndex())),
+			uintKV("last-change", uint64(info.GetLastChange())),
+			uintKV("high-speed", uint64(info.GetHighSpeed())),
+		)
+		if in := info.GetIngressStats(); in != nil {
+			usage.Kv = append(usage.Kv,
+				uintKV("ingress-stats/if-packets", in.GetIfPackets()),
+				uintKV("ingress-stats/if-octets", in.GetIfOctets()),
+				uintKV("ingress-stats/if-ucast-packets", in.GetIfUcastPackets()),
+				uintKV("ingress-stats/if-mcast-packets", in.GetIfMcastPackets()),
+			)
+			for _, fc := range in.IfFcStats {
+				key := fmt.Sprintf("ingress-stats/if-fc-stats[fc-number='%d'][if-family='%s']/", fc.GetFcNumber(), fc.GetIfFamily())
+				usage.Kv = append(usage.Kv,
+					uintKV(key+"if-packets", fc.GetIfPackets()),
+					uintKV(key+"if-octets", fc.GetIfOctets()),
+					uintKV(key+"if-v6-packets", fc.GetIfV6Packets()),
+					uintKV(key+"if-v6-octets", fc.GetIfV6Octets()),
+				)
+			}
+		}
+		if out := info.GetEgressStats(); out != nil {
+			usage.Kv = append(usage.Kv,
+				uintKV("egress-stats/if-packets", out.GetIfPackets()),
+				uintKV("egress-stats/if-octets", out.GetIfOctets()),
+				uintKV("egress-stats/if-ucast-packets", out.GetIfUcastPackets()),
+				uintKV("egress-stats/if-mcast-packets", out.GetIfMcastPackets()),
+			)
+		}
+		usage.Kv = append(usage.Kv, queueKVs("ingress-queue-info", info.IngressQueueInfo)...)
+		usage.Kv = append(usage.Kv, queueKVs("egress-queue-info", info.EgressQueueInfo)...)
+	}
+	s.decode(linecardLogicalIf, usage, hostname)
+}
+
+func queueKVs(list string, queues []*jti_pb.LogicalInterfaceQueueStats) []*na_pb.KeyValue {
+	var kvs []*na_pb.KeyValue
+	for _, q := range queues {
+		key := fmt.Sprintf("%s[queue-number='%d']/", list, q.GetQueueNumber())
+		kvs = append(kvs,
+			uintKV(key+"packets", q.GetPackets()),
+			uintKV(key+"bytes", q.GetBytes()),
+			uintKV(key+"tail-drop-packets", q.GetTailDropPackets()),
+			uintKV(key+"rl-drop-packets", q.GetRlDropPackets()),
+			uintKV(key+"rl-drop-bytes", q.GetRlDropBytes()),
+			uintKV(key+"red-drop-packets", q.GetRedDropPackets()),
+			uintKV(key+"red-drop-bytes", q.GetRedDropBytes()),
+			uintKV(key+"avg-buffer-occupancy", q.GetAvgBufferOccupancy()),
+			uintKV(key+"cur-buffer-occupancy", q.GetCurBufferOccupancy()),
+			uintKV(key+"peak-buffer-occupancy", q.GetPeakBufferOccupancy()),
+			uintKV(key+"allocated-buffer-size", q.GetAllocatedBufferSize()),
+		)
+	}
+	return kvs
+}
+
+func (s *udpSrv) udpFirewallStats(fw *jti_pb.Firewall, hostname string, timestamp time.Time) {
+	for _, f := range fw.FirewallStats {
+		for _, c := range f.CounterStats {
+			s.pointCh <- &FirewallStats{
+				Host:      hostname,
+				Filter:    f.GetFilterName(),
+				Name:      c.GetName(),
+				Type:      fwTypeCounter,
+				Packets:   int64(c.GetPackets()),
+				Bytes:     int64(c.GetBytes()),
+				Timestamp: timestamp,
+			}
+		}
+		for _, p := range f.PolicerStats {
+			s.pointCh <- &FirewallStats{
+				Host:      hostname,
+				Filter:    f.GetFilterName(),
+				Name:      p.GetName(),
+				Type:      fwTypePolicer,
+				Packets:   int64(p.GetOutOfSpecPackets()),
+				Bytes:     int64(p.GetOutOfSpecBytes()),
+				Timestamp: timestamp,
+			}
+		}
+	}
+}
+
+func (s *udpSrv) udpLspStats(lsp *jti_pb.LspStats, hostname string, timestamp time.Time) {
+	for _, r := range lsp.LspStatsRecords {
+		s.pointCh <- &LspStats{
+			Host:        hostname,
+			Name:        r.GetName(),
+			InstanceID:  int64(r.GetInstanceIdentifier()),
+			CounterName: r.GetCounterName(),
+			Packets:     int64(r.GetPackets()),
+			Bytes:       int64(r.GetBytes()),
+			PacketRate:  int64(r.GetPacketRate()),
+			ByteRate:    int64(r.GetByteRate()),
+			Timestamp:   timestamp,
+		}
+	}
+}
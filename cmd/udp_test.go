@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	jti_pb "sticoll/jti"
+	"sticoll/rest"
+
+	"github.com/golang/protobuf/proto"
+)
+
+func TestUDPSource(t *testing.T) {
+	devices := newDeviceRegistry(make(chan dataPoint, 100))
+	known := devices.add(&rest.GRPCCfg{Host: "192.0.2.1"})
+	s := &udpSrv{devices: devices, sources: make(map[string]*udpSource)}
+	ts := &jti_pb.TelemetryStream{SystemId: proto.String("r9:192.0.2.9")}
+
+	src := s.source("192.0.2.1", ts)
+	if !src.known || src.dev != known || src.host != "192.0.2.1" {
+		t.Errorf("configured device: got %+v", src)
+	}
+	unknown := s.source("192.0.2.9", ts)
+	if unknown.known || unknown.host != "r9" {
+		t.Errorf("unknown device: got %+v", unknown)
+	}
+	if s.source("192.0.2.9", ts) != unknown {
+		t.Error("unknown source is not cached")
+	}
+
+	// configuring the device later matches it from then on
+	added := devices.add(&rest.GRPCCfg{Host: "192.0.2.9"})
+	src = s.source("192.0.2.9", ts)
+	if !src.known || src.dev != added {
+		t.Errorf("device added later: got %+v", src)
+	}
+}
+
+func TestUDPPhyIfStats(t *testing.T) {
+	useSensorsToml(t)
+	start := time.Now().Truncate(time.Millisecond)
+	port := &jti_pb.GPort{InterfaceStats: []*jti_pb.InterfaceInfos{{
+		IfName:                 proto.String("xe-0/0/0"),
+		ParentAeName:           proto.String("ae0"),
+		IfAdministrationStatus: proto.String("UP"),
+		IfOperationalStatus:    proto.String("DOWN"),
+		IfDescription:          proto.String("uplink"),
+		IfTransitions:          proto.Uint64(3),
+		IngressStats:           &jti_pb.InterfaceStats{IfOctets: proto.Uint64(100), IfUcPkts: proto.Uint64(10)},
+		EgressStats:            &jti_pb.InterfaceStats{IfOctets: proto.Uint64(200)},
+		IngressErrors:          &jti_pb.IngressInterfaceErrors{IfErrors: proto.Uint64(1)},
+	}}}
+	ch := make(chan dataPoint, 100)
+	s := newinterfaceStats(ch, nil)
+	s.udpPhyIfStats(port, "r1", start)
+	var got []string
+	for len(ch) > 0 {
+		r := (<-ch).Record()
+		if !r.Timestamp.Equal(start) {
+			t.Errorf("time %v, want %v", r.Timestamp, start)
+		}
+		got = append(got, fmt.Sprintf("%s %v %v", r.Measurement, r.Tags, r.Fields))
+	}
+	sort.Strings(got)
+	want := []string{
+		"phy_interface map[admin_state:UP ae_name:ae0 desc:uplink host:r1 name:xe-0/0/0 oper_state:DOWN] " +
+			"map[carrier_transitions:3 counters_in_broadcast_pkts:0 counters_in_errors:1 counters_in_multicast_pkts:0 counters_in_octets:100 counters_in_unicast_pkts:10 " +
+			"counters_out_broadcast_pkts:0 counters_out_multicast_pkts:0 counters_out_octets:200 counters_out_unicast_pkts:0 high_speed:0 last_change:0]",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestUDPLogicalIfStats(t *testing.T) {
+	useSensorsToml(t)
+	start := time.Now().Truncate(time.Millisecond)
+	fc := func(family string, number uint32, pkts uint64) *jti_pb.ForwardingClassAccounting {
+		return &jti_pb.ForwardingClassAccounting{IfFamily: proto.String(family), FcNumber: proto.Uint32(number), IfPackets: proto.Uint64(pkts), IfOctets: proto.Uint64(100 * pkts)}
+	}
+	lp := &jti_pb.LogicalPort{InterfaceInfo: []*jti_pb.LogicalInterfaceInfo{{
+		IfName:               proto.String("xe-0/0/0.100"),
+		SnmpIfIndex:          proto.Uint32(540),
+		AdministrativeStatus: proto.String("up"),
+		OpState:              &jti_pb.OperationalState{OperationalStatus: proto.String("up")},
+		Description:          proto.String("customer"),
+		IngressStats: &jti_pb.IngressInterfaceStats{
+			IfPackets: proto.Uint64(7),
+			// the classes of a family add up
+			IfFcStats: []*jti_pb.ForwardingClassAccounting{fc("inet", 0, 5), fc("inet", 3, 1), fc("inet6", 0, 1)},
+		},
+		EgressStats:     &jti_pb.EgressInterfaceStats{IfPackets: proto.Uint64(9)},
+		EgressQueueInfo: []*jti_pb.LogicalInterfaceQueueStats{{QueueNumber: proto.Uint32(3), Packets: proto.Uint64(9)}},
+	}}}
+	ch := make(chan dataPoint, 100)
+	s := newinterfaceStats(ch, nil)
+	s.udpLogicalIfStats(lp, "r1", start)
+	var got []string
+	for len(ch) > 0 {
+		r := (<-ch).Record()
+		got = append(got, fmt.Sprintf("%s %v %v", r.Measurement, r.Tags, r.Fields))
+	}
+	sort.Strings(got)
+	want := []string{
+		"logical_interface map[admin_state:up desc:customer host:r1 name:xe-0/0/0.100 oper_state:up parent:xe-0/0/0 unit:100] " +
+			"map[high_speed:0 in_multicast_pkts:0 in_octets:0 in_pkts:7 in_unicast_pkts:0 " +
+			"inet6_in_octets:100 inet6_in_pkts:1 inet6_in_v6_octets:0 inet6_in_v6_pkts:0 inet_in_octets:600 inet_in_pkts:6 inet_in_v6_octets:0 inet_in_v6_pkts:0 " +
+			"last_change:0 out_multicast_pkts:0 out_octets:0 out_pkts:9 out_unicast_pkts:0 snmp_index:540]",
+		"logical_queue_stats map[direction:egress host:r1 name:xe-0/0/0.100 queue:3] " +
+			"map[allocated_buffer_size:0 avg_buffer_occupancy:0 bytes:0 cur_buffer_occupancy:0 peak_buffer_occupancy:0 pkts:9 " +
+			"red_drop_bytes:0 red_drop_pkts:0 rl_drop_bytes:0 rl_drop_pkts:0 tail_drop_pkts:0]",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got\n%v\nwant\n%v", got, want)
+	}
+}
@@ -0,0 +1,1796 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: gnmi.proto
+
+package gnmi
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Encoding defines the value encoding formats that are supported by the gNMI
+// protocol. These encodings are used by both the client (when sending Set
+// messages to modify the state of the target) and the target when serializing
+// data to be returned to the client (in both Subscribe and Get RPCs).
+type Encoding int32
+
+const (
+	Encoding_JSON      Encoding = 0
+	Encoding_BYTES     Encoding = 1
+	Encoding_PROTO     Encoding = 2
+	Encoding_ASCII     Encoding = 3
+	Encoding_JSON_IETF Encoding = 4
+)
+
+var Encoding_name = map[int32]string{
+	0: "JSON",
+	1: "BYTES",
+	2: "PROTO",
+	3: "ASCII",
+	4: "JSON_IETF",
+}
+var Encoding_value = map[string]int32{
+	"JSON":      0,
+	"BYTES":     1,
+	"PROTO":     2,
+	"ASCII":     3,
+	"JSON_IETF": 4,
+}
+
+func (x Encoding) String() string {
+	return proto.EnumName(Encoding_name, int32(x))
+}
+func (Encoding) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{0}
+}
+
+// SubscriptionMode is the mode of the subscription, specifying how the
+// target must return values in a subscription.
+type SubscriptionMode int32
+
+const (
+	SubscriptionMode_TARGET_DEFINED SubscriptionMode = 0
+	SubscriptionMode_ON_CHANGE      SubscriptionMode = 1
+	SubscriptionMode_SAMPLE         SubscriptionMode = 2
+)
+
+var SubscriptionMode_name = map[int32]string{
+	0: "TARGET_DEFINED",
+	1: "ON_CHANGE",
+	2: "SAMPLE",
+}
+var SubscriptionMode_value = map[string]int32{
+	"TARGET_DEFINED": 0,
+	"ON_CHANGE":      1,
+	"SAMPLE":         2,
+}
+
+func (x SubscriptionMode) String() string {
+	return proto.EnumName(SubscriptionMode_name, int32(x))
+}
+func (SubscriptionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{1}
+}
+
+// Mode of the subscription.
+type SubscriptionList_Mode int32
+
+const (
+	SubscriptionList_STREAM SubscriptionList_Mode = 0
+	SubscriptionList_ONCE   SubscriptionList_Mode = 1
+	SubscriptionList_POLL   SubscriptionList_Mode = 2
+)
+
+var SubscriptionList_Mode_name = map[int32]string{
+	0: "STREAM",
+	1: "ONCE",
+	2: "POLL",
+}
+var SubscriptionList_Mode_value = map[string]int32{
+	"STREAM": 0,
+	"ONCE":   1,
+	"POLL":   2,
+}
+
+func (x SubscriptionList_Mode) String() string {
+	return proto.EnumName(SubscriptionList_Mode_name, int32(x))
+}
+func (SubscriptionList_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{12, 0}
+}
+
+// Notification is a re-usable message that is used to encode data from the
+// target to the client.
+type Notification struct {
+	Timestamp            int64     `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Prefix               *Path     `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Alias                string    `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	Update               []*Update `protobuf:"bytes,4,rep,name=update,proto3" json:"update,omitempty"`
+	Delete               []*Path   `protobuf:"bytes,5,rep,name=delete,proto3" json:"delete,omitempty"`
+	Atomic               bool      `protobuf:"varint,6,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Notification) Reset()         { *m = Notification{} }
+func (m *Notification) String() string { return proto.CompactTextString(m) }
+func (*Notification) ProtoMessage()    {}
+func (*Notification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{0}
+}
+func (m *Notification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Notification.Unmarshal(m, b)
+}
+func (m *Notification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Notification.Marshal(b, m, deterministic)
+}
+func (dst *Notification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notification.Merge(dst, src)
+}
+func (m *Notification) XXX_Size() int {
+	return xxx_messageInfo_Notification.Size(m)
+}
+func (m *Notification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notification proto.InternalMessageInfo
+
+func (m *Notification) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Notification) GetPrefix() *Path {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *Notification) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+func (m *Notification) GetUpdate() []*Update {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+func (m *Notification) GetDelete() []*Path {
+	if m != nil {
+		return m.Delete
+	}
+	return nil
+}
+
+func (m *Notification) GetAtomic() bool {
+	if m != nil {
+		return m.Atomic
+	}
+	return false
+}
+
+// Update is a re-usable message that is used to store a particular Path,
+// Value pair.
+type Update struct {
+	Path                 *Path       `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Value                *Value      `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Deprecated: Do not use.
+	Val                  *TypedValue `protobuf:"bytes,3,opt,name=val,proto3" json:"val,omitempty"`
+	Duplicates           uint32      `protobuf:"varint,4,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Update) Reset()         { *m = Update{} }
+func (m *Update) String() string { return proto.CompactTextString(m) }
+func (*Update) ProtoMessage()    {}
+func (*Update) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{1}
+}
+func (m *Update) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Update.Unmarshal(m, b)
+}
+func (m *Update) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Update.Marshal(b, m, deterministic)
+}
+func (dst *Update) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Update.Merge(dst, src)
+}
+func (m *Update) XXX_Size() int {
+	return xxx_messageInfo_Update.Size(m)
+}
+func (m *Update) XXX_DiscardUnknown() {
+	xxx_messageInfo_Update.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Update proto.InternalMessageInfo
+
+func (m *Update) GetPath() *Path {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (m *Update) GetValue() *Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Update) GetVal() *TypedValue {
+	if m != nil {
+		return m.Val
+	}
+	return nil
+}
+
+func (m *Update) GetDuplicates() uint32 {
+	if m != nil {
+		return m.Duplicates
+	}
+	return 0
+}
+
+// TypedValue is used to encode a value being sent between the client and
+// target (originated by either entity).
+type TypedValue struct {
+	// One of the fields within the val oneof is populated with the value
+	// of the update. The type of the value being included in the Update
+	// determines which field should be populated.
+	//
+	// Types that are valid to be assigned to Value:
+	//	*TypedValue_StringVal
+	//	*TypedValue_IntVal
+	//	*TypedValue_UintVal
+	//	*TypedValue_BoolVal
+	//	*TypedValue_BytesVal
+	//	*TypedValue_FloatVal
+	//	*TypedValue_DecimalVal
+	//	*TypedValue_LeaflistVal
+	//	*TypedValue_JsonVal
+	//	*TypedValue_JsonIetfVal
+	//	*TypedValue_AsciiVal
+	//	*TypedValue_ProtoBytes
+	//	*TypedValue_DoubleVal
+	Value                isTypedValue_Value `protobuf_oneof:"value"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TypedValue) Reset()         { *m = TypedValue{} }
+func (m *TypedValue) String() string { return proto.CompactTextString(m) }
+func (*TypedValue) ProtoMessage()    {}
+func (*TypedValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{2}
+}
+func (m *TypedValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TypedValue.Unmarshal(m, b)
+}
+func (m *TypedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TypedValue.Marshal(b, m, deterministic)
+}
+func (dst *TypedValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TypedValue.Merge(dst, src)
+}
+func (m *TypedValue) XXX_Size() int {
+	return xxx_messageInfo_TypedValue.Size(m)
+}
+func (m *TypedValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_TypedValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TypedValue proto.InternalMessageInfo
+
+type isTypedValue_Value interface {
+	isTypedValue_Value()
+}
+
+type TypedValue_StringVal struct {
+	StringVal string `protobuf:"bytes,1,opt,name=string_val,json=stringVal,proto3,oneof"`
+}
+
+type TypedValue_IntVal struct {
+	IntVal int64 `protobuf:"varint,2,opt,name=int_val,json=intVal,proto3,oneof"`
+}
+
+type TypedValue_UintVal struct {
+	UintVal uint64 `protobuf:"varint,3,opt,name=uint_val,json=uintVal,proto3,oneof"`
+}
+
+type TypedValue_BoolVal struct {
+	BoolVal bool `protobuf:"varint,4,opt,name=bool_val,json=boolVal,proto3,oneof"`
+}
+
+type TypedValue_BytesVal struct {
+	BytesVal []byte `protobuf:"bytes,5,opt,name=bytes_val,json=bytesVal,proto3,oneof"`
+}
+
+type TypedValue_FloatVal struct {
+	FloatVal float32 `protobuf:"fixed32,6,opt,name=float_val,json=floatVal,proto3,oneof"`
+}
+
+type TypedValue_DecimalVal struct {
+	DecimalVal *Decimal64 `protobuf:"bytes,7,opt,name=decimal_val,json=decimalVal,proto3,oneof"`
+}
+
+type TypedValue_LeaflistVal struct {
+	LeaflistVal *ScalarArray `protobuf:"bytes,8,opt,name=leaflist_val,json=leaflistVal,proto3,oneof"`
+}
+
+type TypedValue_JsonVal struct {
+	JsonVal []byte `protobuf:"bytes,10,opt,name=json_val,json=jsonVal,proto3,oneof"`
+}
+
+type TypedValue_JsonIetfVal struct {
+	JsonIetfVal []byte `protobuf:"bytes,11,opt,name=json_ietf_val,json=jsonIetfVal,proto3,oneof"`
+}
+
+type TypedValue_AsciiVal struct {
+	AsciiVal string `protobuf:"bytes,12,opt,name=ascii_val,json=asciiVal,proto3,oneof"`
+}
+
+type TypedValue_ProtoBytes struct {
+	ProtoBytes []byte `protobuf:"bytes,13,opt,name=proto_bytes,json=protoBytes,proto3,oneof"`
+}
+
+type TypedValue_DoubleVal struct {
+	DoubleVal float64 `protobuf:"fixed64,14,opt,name=double_val,json=doubleVal,proto3,oneof"`
+}
+
+func (*TypedValue_StringVal) isTypedValue_Value() {}
+
+func (*TypedValue_IntVal) isTypedValue_Value() {}
+
+func (*TypedValue_UintVal) isTypedValue_Value() {}
+
+func (*TypedValue_BoolVal) isTypedValue_Value() {}
+
+func (*TypedValue_BytesVal) isTypedValue_Value() {}
+
+func (*TypedValue_FloatVal) isTypedValue_Value() {}
+
+func (*TypedValue_DecimalVal) isTypedValue_Value() {}
+
+func (*TypedValue_LeaflistVal) isTypedValue_Value() {}
+
+func (*TypedValue_JsonVal) isTypedValue_Value() {}
+
+func (*TypedValue_JsonIetfVal) isTypedValue_Value() {}
+
+func (*TypedValue_AsciiVal) isTypedValue_Value() {}
+
+func (*TypedValue_ProtoBytes) isTypedValue_Value() {}
+
+func (*TypedValue_DoubleVal) isTypedValue_Value() {}
+
+func (m *TypedValue) GetValue() isTypedValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *TypedValue) GetStringVal() string {
+	if x, ok := m.GetValue().(*TypedValue_StringVal); ok {
+		return x.StringVal
+	}
+	return ""
+}
+
+func (m *TypedValue) GetIntVal() int64 {
+	if x, ok := m.GetValue().(*TypedValue_IntVal); ok {
+		return x.IntVal
+	}
+	return 0
+}
+
+func (m *TypedValue) GetUintVal() uint64 {
+	if x, ok := m.GetValue().(*TypedValue_UintVal); ok {
+		return x.UintVal
+	}
+	return 0
+}
+
+func (m *TypedValue) GetBoolVal() bool {
+	if x, ok := m.GetValue().(*TypedValue_BoolVal); ok {
+		return x.BoolVal
+	}
+	return false
+}
+
+func (m *TypedValue) GetBytesVal() []byte {
+	if x, ok := m.GetValue().(*TypedValue_BytesVal); ok {
+		return x.BytesVal
+	}
+	return nil
+}
+
+func (m *TypedValue) GetFloatVal() float32 {
+	if x, ok := m.GetValue().(*TypedValue_FloatVal); ok {
+		return x.FloatVal
+	}
+	return 0
+}
+
+func (m *TypedValue) GetDecimalVal() *Decimal64 {
+	if x, ok := m.GetValue().(*TypedValue_DecimalVal); ok {
+		return x.DecimalVal
+	}
+	return nil
+}
+
+func (m *TypedValue) GetLeaflistVal() *ScalarArray {
+	if x, ok := m.GetValue().(*TypedValue_LeaflistVal); ok {
+		return x.LeaflistVal
+	}
+	return nil
+}
+
+func (m *TypedValue) GetJsonVal() []byte {
+	if x, ok := m.GetValue().(*TypedValue_JsonVal); ok {
+		return x.JsonVal
+	}
+	return nil
+}
+
+func (m *TypedValue) GetJsonIetfVal() []byte {
+	if x, ok := m.GetValue().(*TypedValue_JsonIetfVal); ok {
+		return x.JsonIetfVal
+	}
+	return nil
+}
+
+func (m *TypedValue) GetAsciiVal() string {
+	if x, ok := m.GetValue().(*TypedValue_AsciiVal); ok {
+		return x.AsciiVal
+	}
+	return ""
+}
+
+func (m *TypedValue) GetProtoBytes() []byte {
+	if x, ok := m.GetValue().(*TypedValue_ProtoBytes); ok {
+		return x.ProtoBytes
+	}
+	return nil
+}
+
+func (m *TypedValue) GetDoubleVal() float64 {
+	if x, ok := m.GetValue().(*TypedValue_DoubleVal); ok {
+		return x.DoubleVal
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*TypedValue) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _TypedValue_OneofMarshaler, _TypedValue_OneofUnmarshaler, _TypedValue_OneofSizer, []interface{}{
+		(*TypedValue_StringVal)(nil),
+		(*TypedValue_IntVal)(nil),
+		(*TypedValue_UintVal)(nil),
+		(*TypedValue_BoolVal)(nil),
+		(*TypedValue_BytesVal)(nil),
+		(*TypedValue_FloatVal)(nil),
+		(*TypedValue_DecimalVal)(nil),
+		(*TypedValue_LeaflistVal)(nil),
+		(*TypedValue_JsonVal)(nil),
+		(*TypedValue_JsonIetfVal)(nil),
+		(*TypedValue_AsciiVal)(nil),
+		(*TypedValue_ProtoBytes)(nil),
+		(*TypedValue_DoubleVal)(nil),
+	}
+}
+
+func _TypedValue_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*TypedValue)
+	// value
+	switch x := m.Value.(type) {
+	case *TypedValue_StringVal:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.StringVal)
+	case *TypedValue_IntVal:
+		b.EncodeVarint(2<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.IntVal))
+	case *TypedValue_UintVal:
+		b.EncodeVarint(3<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.UintVal))
+	case *TypedValue_BoolVal:
+		t := uint64(0)
+		if x.BoolVal {
+			t = 1
+		}
+		b.EncodeVarint(4<<3 | proto.WireVarint)
+		b.EncodeVarint(t)
+	case *TypedValue_BytesVal:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.BytesVal)
+	case *TypedValue_FloatVal:
+		b.EncodeVarint(6<<3 | proto.WireFixed32)
+		b.EncodeFixed32(uint64(math.Float32bits(x.FloatVal)))
+	case *TypedValue_DecimalVal:
+		b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DecimalVal); err != nil {
+			return err
+		}
+	case *TypedValue_LeaflistVal:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.LeaflistVal); err != nil {
+			return err
+		}
+	case *TypedValue_JsonVal:
+		b.EncodeVarint(10<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.JsonVal)
+	case *TypedValue_JsonIetfVal:
+		b.EncodeVarint(11<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.JsonIetfVal)
+	case *TypedValue_AsciiVal:
+		b.EncodeVarint(12<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.AsciiVal)
+	case *TypedValue_ProtoBytes:
+		b.EncodeVarint(13<<3 | proto.WireBytes)
+		b.EncodeRawBytes(x.ProtoBytes)
+	case *TypedValue_DoubleVal:
+		b.EncodeVarint(14<<3 | proto.WireFixed64)
+		b.EncodeFixed64(math.Float64bits(x.DoubleVal))
+	case nil:
+	default:
+		return fmt.Errorf("TypedValue.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _TypedValue_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*TypedValue)
+	switch tag {
+	case 1: // value.string_val
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Value = &TypedValue_StringVal{x}
+		return true, err
+	case 2: // value.int_val
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Value = &TypedValue_IntVal{int64(x)}
+		return true, err
+	case 3: // value.uint_val
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Value = &TypedValue_UintVal{x}
+		return true, err
+	case 4: // value.bool_val
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Value = &TypedValue_BoolVal{x != 0}
+		return true, err
+	case 5: // value.bytes_val
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Value = &TypedValue_BytesVal{x}
+		return true, err
+	case 6: // value.float_val
+		if wire != proto.WireFixed32 {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeFixed32()
+		m.Value = &TypedValue_FloatVal{math.Float32frombits(uint32(x))}
+		return true, err
+	case 7: // value.decimal_val
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Decimal64)
+		err := b.DecodeMessage(msg)
+		m.Value = &TypedValue_DecimalVal{msg}
+		return true, err
+	case 8: // value.leaflist_val
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ScalarArray)
+		err := b.DecodeMessage(msg)
+		m.Value = &TypedValue_LeaflistVal{msg}
+		return true, err
+	case 10: // value.json_val
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Value = &TypedValue_JsonVal{x}
+		return true, err
+	case 11: // value.json_ietf_val
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Value = &TypedValue_JsonIetfVal{x}
+		return true, err
+	case 12: // value.ascii_val
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Value = &TypedValue_AsciiVal{x}
+		return true, err
+	case 13: // value.proto_bytes
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Value = &TypedValue_ProtoBytes{x}
+		return true, err
+	case 14: // value.double_val
+		if wire != proto.WireFixed64 {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeFixed64()
+		m.Value = &TypedValue_DoubleVal{math.Float64frombits(x)}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _TypedValue_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*TypedValue)
+	// value
+	switch x := m.Value.(type) {
+	case *TypedValue_StringVal:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.StringVal)))
+		n += len(x.StringVal)
+	case *TypedValue_IntVal:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.IntVal))
+	case *TypedValue_UintVal:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.UintVal))
+	case *TypedValue_BoolVal:
+		n += 1 // tag and wire
+		n += 1
+	case *TypedValue_BytesVal:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.BytesVal)))
+		n += len(x.BytesVal)
+	case *TypedValue_FloatVal:
+		n += 1 // tag and wire
+		n += 4
+	case *TypedValue_DecimalVal:
+		s := proto.Size(x.DecimalVal)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TypedValue_LeaflistVal:
+		s := proto.Size(x.LeaflistVal)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TypedValue_JsonVal:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.JsonVal)))
+		n += len(x.JsonVal)
+	case *TypedValue_JsonIetfVal:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.JsonIetfVal)))
+		n += len(x.JsonIetfVal)
+	case *TypedValue_AsciiVal:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.AsciiVal)))
+		n += len(x.AsciiVal)
+	case *TypedValue_ProtoBytes:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.ProtoBytes)))
+		n += len(x.ProtoBytes)
+	case *TypedValue_DoubleVal:
+		n += 1 // tag and wire
+		n += 8
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// Path encodes a data tree path as a series of repeated strings, with
+// each element of the path representing a data tree node name and the
+// associated attributes.
+type Path struct {
+	// Elements of the path are no longer encoded as a string, but rather within
+	// the elem field as a PathElem message.
+	Element              []string    `protobuf:"bytes,1,rep,name=element,proto3" json:"element,omitempty"` // Deprecated: Do not use.
+	Origin               string      `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	Elem                 []*PathElem `protobuf:"bytes,3,rep,name=elem,proto3" json:"elem,omitempty"`
+	Target               string      `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Path) Reset()         { *m = Path{} }
+func (m *Path) String() string { return proto.CompactTextString(m) }
+func (*Path) ProtoMessage()    {}
+func (*Path) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{3}
+}
+func (m *Path) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Path.Unmarshal(m, b)
+}
+func (m *Path) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Path.Marshal(b, m, deterministic)
+}
+func (dst *Path) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Path.Merge(dst, src)
+}
+func (m *Path) XXX_Size() int {
+	return xxx_messageInfo_Path.Size(m)
+}
+func (m *Path) XXX_DiscardUnknown() {
+	xxx_messageInfo_Path.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Path proto.InternalMessageInfo
+
+// Deprecated: Do not use.
+func (m *Path) GetElement() []string {
+	if m != nil {
+		return m.Element
+	}
+	return nil
+}
+
+func (m *Path) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *Path) GetElem() []*PathElem {
+	if m != nil {
+		return m.Elem
+	}
+	return nil
+}
+
+func (m *Path) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+// PathElem encodes an element of a gNMI path, along with any attributes (keys)
+// that may be associated with it.
+type PathElem struct {
+	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Key                  map[string]string `protobuf:"bytes,2,rep,name=key,proto3" json:"key,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PathElem) Reset()         { *m = PathElem{} }
+func (m *PathElem) String() string { return proto.CompactTextString(m) }
+func (*PathElem) ProtoMessage()    {}
+func (*PathElem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{4}
+}
+func (m *PathElem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PathElem.Unmarshal(m, b)
+}
+func (m *PathElem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PathElem.Marshal(b, m, deterministic)
+}
+func (dst *PathElem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PathElem.Merge(dst, src)
+}
+func (m *PathElem) XXX_Size() int {
+	return xxx_messageInfo_PathElem.Size(m)
+}
+func (m *PathElem) XXX_DiscardUnknown() {
+	xxx_messageInfo_PathElem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PathElem proto.InternalMessageInfo
+
+func (m *PathElem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PathElem) GetKey() map[string]string {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+// Value encodes a data tree node's value - along with the way in which
+// the value is encoded. This message is deprecated by gNMI 0.3.0.
+//
+// Deprecated: Do not use.
+type Value struct {
+	Value                []byte   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Type                 Encoding `protobuf:"varint,2,opt,name=type,proto3,enum=gnmi.Encoding" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Value) Reset()         { *m = Value{} }
+func (m *Value) String() string { return proto.CompactTextString(m) }
+func (*Value) ProtoMessage()    {}
+func (*Value) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{5}
+}
+func (m *Value) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Value.Unmarshal(m, b)
+}
+func (m *Value) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Value.Marshal(b, m, deterministic)
+}
+func (dst *Value) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Value.Merge(dst, src)
+}
+func (m *Value) XXX_Size() int {
+	return xxx_messageInfo_Value.Size(m)
+}
+func (m *Value) XXX_DiscardUnknown() {
+	xxx_messageInfo_Value.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Value proto.InternalMessageInfo
+
+func (m *Value) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Value) GetType() Encoding {
+	if m != nil {
+		return m.Type
+	}
+	return Encoding_JSON
+}
+
+// Error message previously utilised to return errors to the client. Deprecated
+// in favour of using the google.golang.org/genproto/googleapis/rpc/status
+// message in the RPC response.
+//
+// Deprecated: Do not use.
+type Error struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Error) Reset()         { *m = Error{} }
+func (m *Error) String() string { return proto.CompactTextString(m) }
+func (*Error) ProtoMessage()    {}
+func (*Error) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{6}
+}
+func (m *Error) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Error.Unmarshal(m, b)
+}
+func (m *Error) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Error.Marshal(b, m, deterministic)
+}
+func (dst *Error) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Error.Merge(dst, src)
+}
+func (m *Error) XXX_Size() int {
+	return xxx_messageInfo_Error.Size(m)
+}
+func (m *Error) XXX_DiscardUnknown() {
+	xxx_messageInfo_Error.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Error proto.InternalMessageInfo
+
+func (m *Error) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *Error) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// Decimal64 is used to encode a fixed precision decimal number. The value
+// is expressed as a set of digits with the precision specifying the
+// number of digits following the decimal point in the digit set.
+type Decimal64 struct {
+	Digits               int64    `protobuf:"varint,1,opt,name=digits,proto3" json:"digits,omitempty"`
+	Precision            uint32   `protobuf:"varint,2,opt,name=precision,proto3" json:"precision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Decimal64) Reset()         { *m = Decimal64{} }
+func (m *Decimal64) String() string { return proto.CompactTextString(m) }
+func (*Decimal64) ProtoMessage()    {}
+func (*Decimal64) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{7}
+}
+func (m *Decimal64) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Decimal64.Unmarshal(m, b)
+}
+func (m *Decimal64) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Decimal64.Marshal(b, m, deterministic)
+}
+func (dst *Decimal64) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Decimal64.Merge(dst, src)
+}
+func (m *Decimal64) XXX_Size() int {
+	return xxx_messageInfo_Decimal64.Size(m)
+}
+func (m *Decimal64) XXX_DiscardUnknown() {
+	xxx_messageInfo_Decimal64.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Decimal64 proto.InternalMessageInfo
+
+func (m *Decimal64) GetDigits() int64 {
+	if m != nil {
+		return m.Digits
+	}
+	return 0
+}
+
+func (m *Decimal64) GetPrecision() uint32 {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+// ScalarArray is used to encode a mixed-type array of values.
+type ScalarArray struct {
+	// The set of elements within the array. Each TypedValue message should
+	// specify only elements that have a field identifier of 1-7 (i.e., the
+	// values are scalar values).
+	Element              []*TypedValue `protobuf:"bytes,1,rep,name=element,proto3" json:"element,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ScalarArray) Reset()         { *m = ScalarArray{} }
+func (m *ScalarArray) String() string { return proto.CompactTextString(m) }
+func (*ScalarArray) ProtoMessage()    {}
+func (*ScalarArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{8}
+}
+func (m *ScalarArray) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScalarArray.Unmarshal(m, b)
+}
+func (m *ScalarArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScalarArray.Marshal(b, m, deterministic)
+}
+func (dst *ScalarArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalarArray.Merge(dst, src)
+}
+func (m *ScalarArray) XXX_Size() int {
+	return xxx_messageInfo_ScalarArray.Size(m)
+}
+func (m *ScalarArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalarArray.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalarArray proto.InternalMessageInfo
+
+func (m *ScalarArray) GetElement() []*TypedValue {
+	if m != nil {
+		return m.Element
+	}
+	return nil
+}
+
+// SubscribeRequest is the message sent by the client to the target when
+// initiating a subscription to a set of paths within the data tree. The
+// request field must be populated and the initial message must specify a
+// SubscriptionList to initiate a subscription.
+type SubscribeRequest struct {
+	// Types that are valid to be assigned to Request:
+	//	*SubscribeRequest_Subscribe
+	//	*SubscribeRequest_Poll
+	Request              isSubscribeRequest_Request `protobuf_oneof:"request"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{9}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(dst, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRequest.Size(m)
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+type isSubscribeRequest_Request interface {
+	isSubscribeRequest_Request()
+}
+
+type SubscribeRequest_Subscribe struct {
+	Subscribe *SubscriptionList `protobuf:"bytes,1,opt,name=subscribe,proto3,oneof"`
+}
+
+type SubscribeRequest_Poll struct {
+	Poll *Poll `protobuf:"bytes,3,opt,name=poll,proto3,oneof"`
+}
+
+func (*SubscribeRequest_Subscribe) isSubscribeRequest_Request() {}
+
+func (*SubscribeRequest_Poll) isSubscribeRequest_Request() {}
+
+func (m *SubscribeRequest) GetRequest() isSubscribeRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetSubscribe() *SubscriptionList {
+	if x, ok := m.GetRequest().(*SubscribeRequest_Subscribe); ok {
+		return x.Subscribe
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetPoll() *Poll {
+	if x, ok := m.GetRequest().(*SubscribeRequest_Poll); ok {
+		return x.Poll
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*SubscribeRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _SubscribeRequest_OneofMarshaler, _SubscribeRequest_OneofUnmarshaler, _SubscribeRequest_OneofSizer, []interface{}{
+		(*SubscribeRequest_Subscribe)(nil),
+		(*SubscribeRequest_Poll)(nil),
+	}
+}
+
+func _SubscribeRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*SubscribeRequest)
+	// request
+	switch x := m.Request.(type) {
+	case *SubscribeRequest_Subscribe:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Subscribe); err != nil {
+			return err
+		}
+	case *SubscribeRequest_Poll:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Poll); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("SubscribeRequest.Request has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _SubscribeRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*SubscribeRequest)
+	switch tag {
+	case 1: // request.subscribe
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SubscriptionList)
+		err := b.DecodeMessage(msg)
+		m.Request = &SubscribeRequest_Subscribe{msg}
+		return true, err
+	case 3: // request.poll
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Poll)
+		err := b.DecodeMessage(msg)
+		m.Request = &SubscribeRequest_Poll{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _SubscribeRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*SubscribeRequest)
+	// request
+	switch x := m.Request.(type) {
+	case *SubscribeRequest_Subscribe:
+		s := proto.Size(x.Subscribe)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *SubscribeRequest_Poll:
+		s := proto.Size(x.Poll)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// Poll is sent within a SubscribeRequest to trigger the device to
+// send telemetry updates for the paths that are associated with the
+// subscription.
+type Poll struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Poll) Reset()         { *m = Poll{} }
+func (m *Poll) String() string { return proto.CompactTextString(m) }
+func (*Poll) ProtoMessage()    {}
+func (*Poll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{10}
+}
+func (m *Poll) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Poll.Unmarshal(m, b)
+}
+func (m *Poll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Poll.Marshal(b, m, deterministic)
+}
+func (dst *Poll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Poll.Merge(dst, src)
+}
+func (m *Poll) XXX_Size() int {
+	return xxx_messageInfo_Poll.Size(m)
+}
+func (m *Poll) XXX_DiscardUnknown() {
+	xxx_messageInfo_Poll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Poll proto.InternalMessageInfo
+
+// SubscribeResponse is the message used by the target within a Subscribe RPC.
+// The target includes a Notification message which is used to transmit values
+// of the path(s) that are associated with the subscription. The same message
+// is to indicate that the target has sent all data values once (is
+// synchronized).
+type SubscribeResponse struct {
+	// Types that are valid to be assigned to Response:
+	//	*SubscribeResponse_Update
+	//	*SubscribeResponse_SyncResponse
+	//	*SubscribeResponse_Error
+	Response             isSubscribeResponse_Response `protobuf_oneof:"response"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
+func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeResponse) ProtoMessage()    {}
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{11}
+}
+func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeResponse.Unmarshal(m, b)
+}
+func (m *SubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeResponse.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeResponse.Merge(dst, src)
+}
+func (m *SubscribeResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscribeResponse.Size(m)
+}
+func (m *SubscribeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeResponse proto.InternalMessageInfo
+
+type isSubscribeResponse_Response interface {
+	isSubscribeResponse_Response()
+}
+
+type SubscribeResponse_Update struct {
+	Update *Notification `protobuf:"bytes,1,opt,name=update,proto3,oneof"`
+}
+
+type SubscribeResponse_SyncResponse struct {
+	SyncResponse bool `protobuf:"varint,3,opt,name=sync_response,json=syncResponse,proto3,oneof"`
+}
+
+type SubscribeResponse_Error struct {
+	Error *Error `protobuf:"bytes,4,opt,name=error,proto3,oneof"`
+}
+
+func (*SubscribeResponse_Update) isSubscribeResponse_Response() {}
+
+func (*SubscribeResponse_SyncResponse) isSubscribeResponse_Response() {}
+
+func (*SubscribeResponse_Error) isSubscribeResponse_Response() {}
+
+func (m *SubscribeResponse) GetResponse() isSubscribeResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *SubscribeResponse) GetUpdate() *Notification {
+	if x, ok := m.GetResponse().(*SubscribeResponse_Update); ok {
+		return x.Update
+	}
+	return nil
+}
+
+func (m *SubscribeResponse) GetSyncResponse() bool {
+	if x, ok := m.GetResponse().(*SubscribeResponse_SyncResponse); ok {
+		return x.SyncResponse
+	}
+	return false
+}
+
+func (m *SubscribeResponse) GetError() *Error {
+	if x, ok := m.GetResponse().(*SubscribeResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*SubscribeResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _SubscribeResponse_OneofMarshaler, _SubscribeResponse_OneofUnmarshaler, _SubscribeResponse_OneofSizer, []interface{}{
+		(*SubscribeResponse_Update)(nil),
+		(*SubscribeResponse_SyncResponse)(nil),
+		(*SubscribeResponse_Error)(nil),
+	}
+}
+
+func _SubscribeResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*SubscribeResponse)
+	// response
+	switch x := m.Response.(type) {
+	case *SubscribeResponse_Update:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Update); err != nil {
+			return err
+		}
+	case *SubscribeResponse_SyncResponse:
+		t := uint64(0)
+		if x.SyncResponse {
+			t = 1
+		}
+		b.EncodeVarint(3<<3 | proto.WireVarint)
+		b.EncodeVarint(t)
+	case *SubscribeResponse_Error:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("SubscribeResponse.Response has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _SubscribeResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*SubscribeResponse)
+	switch tag {
+	case 1: // response.update
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Notification)
+		err := b.DecodeMessage(msg)
+		m.Response = &SubscribeResponse_Update{msg}
+		return true, err
+	case 3: // response.sync_response
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Response = &SubscribeResponse_SyncResponse{x != 0}
+		return true, err
+	case 4: // response.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Error)
+		err := b.DecodeMessage(msg)
+		m.Response = &SubscribeResponse_Error{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _SubscribeResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*SubscribeResponse)
+	// response
+	switch x := m.Response.(type) {
+	case *SubscribeResponse_Update:
+		s := proto.Size(x.Update)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *SubscribeResponse_SyncResponse:
+		n += 1 // tag and wire
+		n += 1
+	case *SubscribeResponse_Error:
+		s := proto.Size(x.Error)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// SubscriptionList is used within a Subscribe message to specify the list of
+// paths that the client wishes to subscribe to. The message consists of a
+// list of (possibly prefixed) paths, and options that relate to the
+// subscription.
+type SubscriptionList struct {
+	Prefix       *Path           `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Subscription []*Subscription `protobuf:"bytes,2,rep,name=subscription,proto3" json:"subscription,omitempty"`
+	// Whether target defined aliases are allowed within the subscription.
+	UseAliases bool                  `protobuf:"varint,3,opt,name=use_aliases,json=useAliases,proto3" json:"use_aliases,omitempty"`
+	Mode       SubscriptionList_Mode `protobuf:"varint,5,opt,name=mode,proto3,enum=gnmi.SubscriptionList_Mode" json:"mode,omitempty"`
+	// Whether elements of the schema that are marked as eligible for aggregation
+	// should be aggregated or not.
+	AllowAggregation bool `protobuf:"varint,6,opt,name=allow_aggregation,json=allowAggregation,proto3" json:"allow_aggregation,omitempty"`
+	// The encoding that the target should use within the Notifications generated
+	// corresponding to the SubscriptionList.
+	Encoding Encoding `protobuf:"varint,8,opt,name=encoding,proto3,enum=gnmi.Encoding" json:"encoding,omitempty"`
+	// An optional field to specify that only updates to current state should be
+	// sent to a client. If set, the initial state is not sent to the client but
+	// rather only the sync message followed by any subsequent updates to the
+	// current state. For ONCE and POLL modes, this causes the server to send only
+	// the sync message (Sec. 3.5.2.3).
+	UpdatesOnly          bool     `protobuf:"varint,9,opt,name=updates_only,json=updatesOnly,proto3" json:"updates_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscriptionList) Reset()         { *m = SubscriptionList{} }
+func (m *SubscriptionList) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionList) ProtoMessage()    {}
+func (*SubscriptionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{12}
+}
+func (m *SubscriptionList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionList.Unmarshal(m, b)
+}
+func (m *SubscriptionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionList.Marshal(b, m, deterministic)
+}
+func (dst *SubscriptionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionList.Merge(dst, src)
+}
+func (m *SubscriptionList) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionList.Size(m)
+}
+func (m *SubscriptionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionList proto.InternalMessageInfo
+
+func (m *SubscriptionList) GetPrefix() *Path {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *SubscriptionList) GetSubscription() []*Subscription {
+	if m != nil {
+		return m.Subscription
+	}
+	return nil
+}
+
+func (m *SubscriptionList) GetUseAliases() bool {
+	if m != nil {
+		return m.UseAliases
+	}
+	return false
+}
+
+func (m *SubscriptionList) GetMode() SubscriptionList_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return SubscriptionList_STREAM
+}
+
+func (m *SubscriptionList) GetAllowAggregation() bool {
+	if m != nil {
+		return m.AllowAggregation
+	}
+	return false
+}
+
+func (m *SubscriptionList) GetEncoding() Encoding {
+	if m != nil {
+		return m.Encoding
+	}
+	return Encoding_JSON
+}
+
+func (m *SubscriptionList) GetUpdatesOnly() bool {
+	if m != nil {
+		return m.UpdatesOnly
+	}
+	return false
+}
+
+// Subscription is a single request within a SubscriptionList. The path
+// specified is interpreted (along with the prefix) as the elements of the data
+// tree that the client is subscribing to. The mode determines how the target
+// should trigger updates to be sent.
+type Subscription struct {
+	Path           *Path            `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Mode           SubscriptionMode `protobuf:"varint,2,opt,name=mode,proto3,enum=gnmi.SubscriptionMode" json:"mode,omitempty"`
+	SampleInterval uint64           `protobuf:"varint,3,opt,name=sample_interval,json=sampleInterval,proto3" json:"sample_interval,omitempty"`
+	// Indicates whether values that have not changed should be sent in a SAMPLE
+	// subscription.
+	SuppressRedundant bool `protobuf:"varint,4,opt,name=suppress_redundant,json=suppressRedundant,proto3" json:"suppress_redundant,omitempty"`
+	// Specifies the maximum allowable silent period in nanoseconds when
+	// suppress_redundant is in use. The target should send a value at least once
+	// in the period specified.
+	HeartbeatInterval    uint64   `protobuf:"varint,5,opt,name=heartbeat_interval,json=heartbeatInterval,proto3" json:"heartbeat_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gnmi_d4e0e69eefb0f9a2, []int{13}
+}
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Subscription.Unmarshal(m, b)
+}
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
+}
+func (dst *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(dst, src)
+}
+func (m *Subscription) XXX_Size() int {
+	return xxx_messageInfo_Subscription.Size(m)
+}
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
+
+func (m *Subscription) GetPath() *Path {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *Subscription) GetMode() SubscriptionMode {
+	if m != nil {
+		return m.Mode
+	}
+	return SubscriptionMode_TARGET_DEFINED
+}
+
+func (m *Subscription) GetSampleInterval() uint64 {
+	if m != nil {
+		return m.SampleInterval
+	}
+	return 0
+}
+
+func (m *Subscription) GetSuppressRedundant() bool {
+	if m != nil {
+		return m.SuppressRedundant
+	}
+	return false
+}
+
+func (m *Subscription) GetHeartbeatInterval() uint64 {
+	if m != nil {
+		return m.HeartbeatInterval
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Notification)(nil), "gnmi.Notification")
+	proto.RegisterType((*Update)(nil), "gnmi.Update")
+	proto.RegisterType((*TypedValue)(nil), "gnmi.TypedValue")
+	proto.RegisterType((*Path)(nil), "gnmi.Path")
+	proto.RegisterType((*PathElem)(nil), "gnmi.PathElem")
+	proto.RegisterMapType((map[string]string)(nil), "gnmi.PathElem.KeyEntry")
+	proto.RegisterType((*Value)(nil), "gnmi.Value")
+	proto.RegisterType((*Error)(nil), "gnmi.Error")
+	proto.RegisterType((*Decimal64)(nil), "gnmi.Decimal64")
+	proto.RegisterType((*ScalarArray)(nil), "gnmi.ScalarArray")
+	proto.RegisterType((*SubscribeRequest)(nil), "gnmi.SubscribeRequest")
+	proto.RegisterType((*Poll)(nil), "gnmi.Poll")
+	proto.RegisterType((*SubscribeResponse)(nil), "gnmi.SubscribeResponse")
+	proto.RegisterType((*SubscriptionList)(nil), "gnmi.SubscriptionList")
+	proto.RegisterType((*Subscription)(nil), "gnmi.Subscription")
+	proto.RegisterEnum("gnmi.Encoding", Encoding_name, Encoding_value)
+	proto.RegisterEnum("gnmi.SubscriptionMode", SubscriptionMode_name, SubscriptionMode_value)
+	proto.RegisterEnum("gnmi.SubscriptionList_Mode", SubscriptionList_Mode_name, SubscriptionList_Mode_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GNMIClient is the client API for GNMI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GNMIClient interface {
+	// Subscribe allows a client to request the target to send it values
+	// of particular paths within the data tree. These values may be streamed
+	// at a particular cadence (STREAM), sent one off on a long-lived channel
+	// (POLL), or sent as a one-off retrieval (ONCE).
+	Subscribe(ctx context.Context, opts ...grpc.CallOption) (GNMI_SubscribeClient, error)
+}
+
+type gNMIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGNMIClient(cc *grpc.ClientConn) GNMIClient {
+	return &gNMIClient{cc}
+}
+
+func (c *gNMIClient) Subscribe(ctx context.Context, opts ...grpc.CallOption) (GNMI_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GNMI_serviceDesc.Streams[0], "/gnmi.gNMI/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gNMISubscribeClient{stream}
+	return x, nil
+}
+
+type GNMI_SubscribeClient interface {
+	Send(*SubscribeRequest) error
+	Recv() (*SubscribeResponse, error)
+	grpc.ClientStream
+}
+
+type gNMISubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *gNMISubscribeClient) Send(m *SubscribeRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gNMISubscribeClient) Recv() (*SubscribeResponse, error) {
+	m := new(SubscribeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GNMIServer is the server API for GNMI service.
+type GNMIServer interface {
+	// Subscribe allows a client to request the target to send it values
+	// of particular paths within the data tree. These values may be streamed
+	// at a particular cadence (STREAM), sent one off on a long-lived channel
+	// (POLL), or sent as a one-off retrieval (ONCE).
+	Subscribe(GNMI_SubscribeServer) error
+}
+
+func RegisterGNMIServer(s *grpc.Server, srv GNMIServer) {
+	s.RegisterService(&_GNMI_serviceDesc, srv)
+}
+
+func _GNMI_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GNMIServer).Subscribe(&gNMISubscribeServer{stream})
+}
+
+type GNMI_SubscribeServer interface {
+	Send(*SubscribeResponse) error
+	Recv() (*SubscribeRequest, error)
+	grpc.ServerStream
+}
+
+type gNMISubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *gNMISubscribeServer) Send(m *SubscribeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gNMISubscribeServer) Recv() (*SubscribeRequest, error) {
+	m := new(SubscribeRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _GNMI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gnmi.gNMI",
+	HandlerType: (*GNMIServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _GNMI_Subscribe_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "gnmi.proto",
+}
+
+func init() { proto.RegisterFile("gnmi.proto", fileDescriptor_gnmi_d4e0e69eefb0f9a2) }
+
+var fileDescriptor_gnmi_d4e0e69eefb0f9a2 = []byte{
+	// 1217 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xdd, 0x72, 0x1b, 0x35,
+	0x14, 0xf6, 0xda, 0x6b, 0x7b, 0xf7, 0xd8, 0x49, 0x37, 0x9a, 0x4e, 0xbb, 0xb4, 0xa5, 0x75, 0x77,
+	0x5a, 0x6a, 0x02, 0x04, 0x26, 0x30, 0x19, 0xda, 0x2b, 0x9c, 0xc6, 0xad, 0x0d, 0x89, 0x9d, 0x51,
+	0x4c, 0x67, 0xb8, 0xf2, 0xc8, 0xb6, 0xe2, 0x0a, 0xd6, 0xbb, 0xcb, 0x4a, 0x6e, 0xeb, 0x4b, 0x9e,
+	0x81, 0x6b, 0xde, 0x87, 0x77, 0xe0, 0x8a, 0x19, 0x1e, 0x84, 0x39, 0x92, 0xd6, 0x76, 0xda, 0x30,
+	0xdc, 0x49, 0xdf, 0x77, 0xce, 0x27, 0x9d, 0x1f, 0x1d, 0x01, 0xcc, 0x93, 0x85, 0x38, 0xc8, 0xf2,
+	0x54, 0xa5, 0xc4, 0xc5, 0x75, 0xf4, 0xa7, 0x03, 0xcd, 0x41, 0xaa, 0xc4, 0xa5, 0x98, 0x32, 0x25,
+	0xd2, 0x84, 0xdc, 0x03, 0x5f, 0x89, 0x05, 0x97, 0x8a, 0x2d, 0xb2, 0xd0, 0x69, 0x39, 0xed, 0x0a,
+	0xdd, 0x00, 0x24, 0x82, 0x5a, 0x96, 0xf3, 0x4b, 0xf1, 0x2e, 0x2c, 0xb7, 0x9c, 0x76, 0xe3, 0x10,
+	0x0e, 0xb4, 0xe2, 0x39, 0x53, 0xaf, 0xa9, 0x65, 0xc8, 0x4d, 0xa8, 0xb2, 0x58, 0x30, 0x19, 0x56,
+	0x5a, 0x4e, 0xdb, 0xa7, 0x66, 0x43, 0x1e, 0x41, 0x6d, 0x99, 0xcd, 0x98, 0xe2, 0xa1, 0xdb, 0xaa,
+	0xb4, 0x1b, 0x87, 0x4d, 0xe3, 0xf9, 0xa3, 0xc6, 0xa8, 0xe5, 0x50, 0x7f, 0xc6, 0x63, 0xae, 0x78,
+	0x58, 0x6d, 0x55, 0xde, 0xd7, 0x37, 0x0c, 0xb9, 0x05, 0x35, 0xa6, 0xd2, 0x85, 0x98, 0x86, 0xb5,
+	0x96, 0xd3, 0xf6, 0xa8, 0xdd, 0x45, 0xbf, 0x3b, 0x50, 0x33, 0x72, 0xe4, 0x3e, 0xb8, 0x19, 0x53,
+	0xaf, 0xf5, 0xfd, 0xaf, 0x8a, 0x68, 0x9c, 0x3c, 0x86, 0xea, 0x1b, 0x16, 0x2f, 0xb9, 0x8d, 0xa2,
+	0x61, 0x0c, 0x5e, 0x21, 0x74, 0x5c, 0x0e, 0x1d, 0x6a, 0x58, 0x12, 0x41, 0xe5, 0x0d, 0x8b, 0x75,
+	0x1c, 0x8d, 0xc3, 0xc0, 0x18, 0x8d, 0x56, 0x19, 0x9f, 0x69, 0x4b, 0x8a, 0x24, 0xb9, 0x0f, 0x30,
+	0x5b, 0x66, 0x31, 0xa6, 0x8f, 0xcb, 0xd0, 0x6d, 0x39, 0xed, 0x1d, 0xba, 0x85, 0x44, 0x7f, 0x55,
+	0x00, 0x36, 0x3e, 0xe4, 0x01, 0x80, 0x54, 0xb9, 0x48, 0xe6, 0x63, 0x54, 0xc6, 0xfb, 0xf9, 0xbd,
+	0x12, 0xf5, 0x0d, 0xf6, 0x8a, 0xc5, 0xe4, 0x23, 0xa8, 0x8b, 0x44, 0x69, 0x16, 0x2f, 0x57, 0xe9,
+	0x95, 0x68, 0x4d, 0x24, 0x0a, 0xa9, 0xbb, 0xe0, 0x2d, 0x0b, 0x0e, 0xef, 0xe4, 0xf6, 0x4a, 0xb4,
+	0xbe, 0xdc, 0x90, 0x93, 0x34, 0x8d, 0x35, 0x89, 0xb7, 0xf0, 0x90, 0x44, 0x04, 0xc9, 0x8f, 0xc1,
+	0x9f, 0xac, 0x14, 0x97, 0x9a, 0xad, 0xb6, 0x9c, 0x76, 0xb3, 0x57, 0xa2, 0x9e, 0x86, 0x2c, 0x7d,
+	0x19, 0xa7, 0xcc, 0x28, 0x63, 0x52, 0xcb, 0x48, 0x6b, 0x08, 0xe9, 0x43, 0x68, 0xcc, 0xf8, 0x54,
+	0x2c, 0x98, 0x51, 0xaf, 0xeb, 0x74, 0xdc, 0x30, 0xe9, 0x38, 0x31, 0xc4, 0xd1, 0x37, 0xbd, 0x12,
+	0x05, 0x6b, 0x85, 0x3e, 0x47, 0xd0, 0x8c, 0x39, 0xbb, 0x8c, 0x85, 0x34, 0xaa, 0x9e, 0x76, 0xda,
+	0x33, 0x4e, 0x17, 0x53, 0x16, 0xb3, 0xbc, 0x93, 0xe7, 0x6c, 0xd5, 0x2b, 0xd1, 0x46, 0x61, 0x68,
+	0xc3, 0xf8, 0x59, 0xa6, 0x89, 0xf6, 0x01, 0x7b, 0xd1, 0x3a, 0x22, 0x48, 0x3e, 0x82, 0x1d, 0x4d,
+	0x0a, 0xae, 0x2e, 0xb5, 0x45, 0xc3, 0x5a, 0x34, 0x10, 0xee, 0x73, 0x75, 0x69, 0xa3, 0x61, 0x72,
+	0x2a, 0x84, 0xb6, 0x68, 0xda, 0x0c, 0x7b, 0x1a, 0x42, 0xfa, 0x21, 0x34, 0xf4, 0x03, 0x18, 0xeb,
+	0xf0, 0xc3, 0x1d, 0x2b, 0x01, 0x1a, 0x3c, 0x46, 0x0c, 0x8b, 0x34, 0x4b, 0x97, 0x93, 0x98, 0x6b,
+	0x89, 0xdd, 0x96, 0xd3, 0x76, 0xb0, 0x48, 0x06, 0x7b, 0xc5, 0xe2, 0xe3, 0xba, 0xed, 0x9f, 0xe8,
+	0x1d, 0xb8, 0xd8, 0x56, 0xe4, 0x1e, 0xd4, 0x79, 0xcc, 0x17, 0x3c, 0x51, 0xa1, 0xd3, 0xaa, 0xb4,
+	0x7d, 0xdd, 0x45, 0x05, 0x84, 0x1d, 0x9b, 0xe6, 0x62, 0x2e, 0x12, 0x5d, 0x52, 0x9f, 0xda, 0x1d,
+	0x89, 0xc0, 0x45, 0x93, 0xb0, 0xa2, 0x7b, 0x7d, 0x77, 0xd3, 0xa6, 0xdd, 0x98, 0x2f, 0xa8, 0xe6,
+	0xd0, 0x57, 0xb1, 0x7c, 0xce, 0x95, 0xae, 0xaa, 0x4f, 0xed, 0x2e, 0xfa, 0xcd, 0x01, 0xaf, 0x30,
+	0x25, 0x04, 0xdc, 0x84, 0x2d, 0xb8, 0xe9, 0x27, 0xaa, 0xd7, 0xe4, 0x53, 0xa8, 0xfc, 0xc2, 0x57,
+	0x61, 0x59, 0x6b, 0xdf, 0xbe, 0xaa, 0x7d, 0xf0, 0x03, 0x5f, 0x75, 0x13, 0x95, 0xaf, 0x28, 0xda,
+	0xdc, 0x39, 0x02, 0xaf, 0x00, 0x48, 0x60, 0xdc, 0x8c, 0x12, 0x2e, 0xc9, 0x4d, 0x1b, 0xac, 0xbd,
+	0xbc, 0xd9, 0x3c, 0x2b, 0x7f, 0xeb, 0x44, 0x5d, 0xa8, 0x9a, 0xae, 0x5e, 0x9b, 0xa0, 0x5b, 0x73,
+	0xf3, 0x7c, 0x5c, 0xb5, 0xca, 0x8c, 0xdf, 0x6e, 0x11, 0x5e, 0x37, 0x99, 0xa6, 0x33, 0x91, 0xcc,
+	0xa9, 0xe6, 0x9e, 0x95, 0x43, 0x27, 0x7a, 0x0a, 0xd5, 0x6e, 0x9e, 0xa7, 0x39, 0x86, 0x31, 0x4d,
+	0x67, 0x46, 0x65, 0x87, 0xea, 0x35, 0x09, 0xa1, 0xbe, 0xe0, 0x52, 0xb2, 0x79, 0x71, 0x7e, 0xb1,
+	0xd5, 0xae, 0x1d, 0xf0, 0xd7, 0x1d, 0x88, 0xa9, 0x9a, 0x89, 0xb9, 0x50, 0xd2, 0xce, 0x2d, 0xbb,
+	0xc3, 0x91, 0x96, 0xe5, 0x7c, 0x2a, 0xa4, 0x48, 0x4d, 0x05, 0x76, 0xe8, 0x06, 0x88, 0x9e, 0x42,
+	0x63, 0xab, 0x1f, 0xc9, 0xfe, 0xd5, 0x4a, 0x5e, 0xf7, 0xee, 0x0b, 0x83, 0xe8, 0x2d, 0x04, 0x17,
+	0xcb, 0x89, 0x9c, 0xe6, 0x62, 0xc2, 0x29, 0xff, 0x75, 0xc9, 0xa5, 0x22, 0x47, 0xe0, 0xcb, 0x02,
+	0xb3, 0xf3, 0xe7, 0x96, 0xed, 0x7a, 0x03, 0x67, 0x38, 0x66, 0x4f, 0x85, 0x54, 0xfa, 0xdd, 0x17,
+	0xa6, 0xa4, 0x05, 0x6e, 0x96, 0xc6, 0xc5, 0xb0, 0x29, 0x46, 0x56, 0x1a, 0xc7, 0xbd, 0x12, 0xd5,
+	0xcc, 0xb1, 0x0f, 0xf5, 0xdc, 0x1c, 0x12, 0xd5, 0xc0, 0x45, 0x2a, 0xfa, 0xc3, 0x81, 0xbd, 0xad,
+	0x1b, 0xc8, 0x2c, 0x4d, 0x24, 0x27, 0x9f, 0xaf, 0x47, 0xad, 0x39, 0x9f, 0x18, 0xb1, 0xed, 0x31,
+	0x8f, 0x53, 0xc5, 0xd8, 0x90, 0xc7, 0xb0, 0x23, 0x57, 0xc9, 0x74, 0x9c, 0x5b, 0xf7, 0xb0, 0x62,
+	0xa7, 0x47, 0x13, 0xe1, 0xb5, 0xe8, 0x13, 0xa8, 0x72, 0x2c, 0x52, 0xe8, 0x6e, 0x8f, 0x4c, 0x5d,
+	0x37, 0x6c, 0xf6, 0x5e, 0x89, 0x1a, 0xfe, 0x18, 0xc0, 0x2b, 0xa4, 0xa2, 0x7f, 0xca, 0x10, 0xbc,
+	0x1f, 0xf6, 0xd6, 0x1f, 0xe2, 0xfc, 0xe7, 0x1f, 0x72, 0x04, 0x4d, 0xb9, 0xe5, 0x67, 0xbb, 0x98,
+	0x7c, 0x98, 0x48, 0x7a, 0xc5, 0x8e, 0x3c, 0x80, 0xc6, 0x52, 0xf2, 0xb1, 0xfe, 0x72, 0xb8, 0xf9,
+	0x81, 0x3c, 0x0a, 0x4b, 0xc9, 0x3b, 0x06, 0x21, 0x5f, 0x82, 0xbb, 0xc0, 0x16, 0xab, 0xea, 0x9e,
+	0xbc, 0x7b, 0x7d, 0x65, 0x0e, 0xce, 0xd2, 0x19, 0xa7, 0xda, 0x90, 0x7c, 0x06, 0x7b, 0x2c, 0x8e,
+	0xd3, 0xb7, 0x63, 0x36, 0x9f, 0xe7, 0x7c, 0xae, 0xb3, 0x67, 0x3f, 0x9e, 0x40, 0x13, 0x9d, 0x0d,
+	0x4e, 0xf6, 0xc1, 0xe3, 0xb6, 0xbf, 0x43, 0xef, 0xda, 0xae, 0x5f, 0xf3, 0xe4, 0x21, 0x34, 0x4d,
+	0x05, 0xe4, 0x38, 0x4d, 0xe2, 0x55, 0xe8, 0x6b, 0xcd, 0x86, 0xc5, 0x86, 0x49, 0xbc, 0x8a, 0x3e,
+	0x01, 0x17, 0x6f, 0x42, 0x00, 0x6a, 0x17, 0x23, 0xda, 0xed, 0x9c, 0x05, 0x25, 0xe2, 0x81, 0x3b,
+	0x1c, 0x3c, 0xef, 0x06, 0x0e, 0xae, 0xce, 0x87, 0xa7, 0xa7, 0x41, 0x39, 0xfa, 0xdb, 0x81, 0xe6,
+	0x76, 0x0c, 0xff, 0xfb, 0xff, 0xed, 0xdb, 0x2c, 0x98, 0x97, 0x79, 0x4d, 0x7f, 0x6e, 0x25, 0xe0,
+	0x09, 0xdc, 0x90, 0x6c, 0x91, 0xc5, 0x7c, 0x2c, 0x12, 0xc5, 0xf3, 0xf5, 0xe7, 0x43, 0x77, 0x0d,
+	0xdc, 0xb7, 0x28, 0xf9, 0x02, 0x88, 0x5c, 0x66, 0x59, 0xce, 0xa5, 0x1c, 0xe7, 0x7c, 0xb6, 0x4c,
+	0x66, 0x2c, 0x31, 0x53, 0xcb, 0xa3, 0x7b, 0x05, 0x43, 0x0b, 0x02, 0xcd, 0x5f, 0x73, 0x96, 0xab,
+	0x09, 0x67, 0x6a, 0x23, 0x5d, 0xd5, 0xd2, 0x7b, 0x6b, 0xa6, 0x50, 0xdf, 0x3f, 0x01, 0xaf, 0x48,
+	0x22, 0x46, 0xfe, 0xfd, 0xc5, 0x70, 0x10, 0x94, 0x88, 0x0f, 0xd5, 0xe3, 0x9f, 0x46, 0xdd, 0x8b,
+	0xc0, 0xc1, 0xe5, 0x39, 0x1d, 0x8e, 0x86, 0x41, 0x19, 0x97, 0x9d, 0x8b, 0xe7, 0xfd, 0x7e, 0x50,
+	0x21, 0x3b, 0xe0, 0xa3, 0xe9, 0xb8, 0xdf, 0x1d, 0xbd, 0x08, 0xdc, 0xfd, 0x0e, 0x04, 0xef, 0x87,
+	0x49, 0x08, 0xec, 0x8e, 0x3a, 0xf4, 0x65, 0x77, 0x34, 0x3e, 0xe9, 0xbe, 0xe8, 0x0f, 0xba, 0x27,
+	0x41, 0x09, 0xdd, 0x86, 0x83, 0xf1, 0xf3, 0x5e, 0x67, 0xf0, 0x12, 0x53, 0x8d, 0x05, 0xe8, 0x9c,
+	0x9d, 0x9f, 0x76, 0x83, 0xf2, 0x61, 0x0f, 0xdc, 0xf9, 0xe0, 0xac, 0x4f, 0xbe, 0x03, 0x7f, 0xfd,
+	0xf4, 0xc8, 0xd5, 0x14, 0xae, 0xa7, 0xc1, 0x9d, 0xdb, 0x1f, 0xe0, 0xe6, 0x65, 0xb4, 0x9d, 0xaf,
+	0x9c, 0x49, 0x4d, 0x7f, 0x39, 0x5f, 0xff, 0x3b, 0x00, 0x2e, 0xe9, 0x11, 0x8b, 0x96, 0x09, 0x00,
+	0x00,
+}
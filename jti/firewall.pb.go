@@ -0,0 +1,508 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: firewall.proto
+
+package jti
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Top-level message
+type Firewall struct {
+	FirewallStats        []*FirewallStats `protobuf:"bytes,1,rep,name=firewall_stats,json=firewallStats" json:"firewall_stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Firewall) Reset()         { *m = Firewall{} }
+func (m *Firewall) String() string { return proto.CompactTextString(m) }
+func (*Firewall) ProtoMessage()    {}
+func (*Firewall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_firewall_27b06245ca42c422, []int{0}
+}
+func (m *Firewall) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Firewall.Unmarshal(m, b)
+}
+func (m *Firewall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Firewall.Marshal(b, m, deterministic)
+}
+func (dst *Firewall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Firewall.Merge(dst, src)
+}
+func (m *Firewall) XXX_Size() int {
+	return xxx_messageInfo_Firewall.Size(m)
+}
+func (m *Firewall) XXX_DiscardUnknown() {
+	xxx_messageInfo_Firewall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Firewall proto.InternalMessageInfo
+
+func (m *Firewall) GetFirewallStats() []*FirewallStats {
+	if m != nil {
+		return m.FirewallStats
+	}
+	return nil
+}
+
+type FirewallStats struct {
+	// Filter name
+	FilterName *string `protobuf:"bytes,1,opt,name=filter_name,json=filterName" json:"filter_name,omitempty"`
+	// Timestamp when filter was last modified
+	Timestamp *uint64 `protobuf:"varint,2,opt,name=timestamp" json:"timestamp,omitempty"`
+	// Memory usage of the filter
+	MemoryUsage []*MemoryUsage `protobuf:"bytes,3,rep,name=memory_usage,json=memoryUsage" json:"memory_usage,omitempty"`
+	// Counter statistics
+	CounterStats []*CounterStats `protobuf:"bytes,4,rep,name=counter_stats,json=counterStats" json:"counter_stats,omitempty"`
+	// Policer statistics
+	PolicerStats []*PolicerStats `protobuf:"bytes,5,rep,name=policer_stats,json=policerStats" json:"policer_stats,omitempty"`
+	// Hierarchical policer statistics
+	HierarchicalPolicerStats []*HierarchicalPolicerStats `protobuf:"bytes,6,rep,name=hierarchical_policer_stats,json=hierarchicalPolicerStats" json:"hierarchical_policer_stats,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}                    `json:"-"`
+	XXX_unrecognized         []byte                      `json:"-"`
+	XXX_sizecache            int32                       `json:"-"`
+}
+
+func (m *FirewallStats) Reset()         { *m = FirewallStats{} }
+func (m *FirewallStats) String() string { return proto.CompactTextString(m) }
+func (*FirewallStats) ProtoMessage()    {}
+func (*FirewallStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_firewall_27b06245ca42c422, []int{1}
+}
+func (m *FirewallStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FirewallStats.Unmarshal(m, b)
+}
+func (m *FirewallStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FirewallStats.Marshal(b, m, deterministic)
+}
+func (dst *FirewallStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FirewallStats.Merge(dst, src)
+}
+func (m *FirewallStats) XXX_Size() int {
+	return xxx_messageInfo_FirewallStats.Size(m)
+}
+func (m *FirewallStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FirewallStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FirewallStats proto.InternalMessageInfo
+
+func (m *FirewallStats) GetFilterName() string {
+	if m != nil && m.FilterName != nil {
+		return *m.FilterName
+	}
+	return ""
+}
+
+func (m *FirewallStats) GetTimestamp() uint64 {
+	if m != nil && m.Timestamp != nil {
+		return *m.Timestamp
+	}
+	return 0
+}
+
+func (m *FirewallStats) GetMemoryUsage() []*MemoryUsage {
+	if m != nil {
+		return m.MemoryUsage
+	}
+	return nil
+}
+
+func (m *FirewallStats) GetCounterStats() []*CounterStats {
+	if m != nil {
+		return m.CounterStats
+	}
+	return nil
+}
+
+func (m *FirewallStats) GetPolicerStats() []*PolicerStats {
+	if m != nil {
+		return m.PolicerStats
+	}
+	return nil
+}
+
+func (m *FirewallStats) GetHierarchicalPolicerStats() []*HierarchicalPolicerStats {
+	if m != nil {
+		return m.HierarchicalPolicerStats
+	}
+	return nil
+}
+
+type MemoryUsage struct {
+	// Memory type
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Allocated memory
+	Allocated            *uint64  `protobuf:"varint,2,opt,name=allocated" json:"allocated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MemoryUsage) Reset()         { *m = MemoryUsage{} }
+func (m *MemoryUsage) String() string { return proto.CompactTextString(m) }
+func (*MemoryUsage) ProtoMessage()    {}
+func (*MemoryUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_firewall_27b06245ca42c422, []int{2}
+}
+func (m *MemoryUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MemoryUsage.Unmarshal(m, b)
+}
+func (m *MemoryUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MemoryUsage.Marshal(b, m, deterministic)
+}
+func (dst *MemoryUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemoryUsage.Merge(dst, src)
+}
+func (m *MemoryUsage) XXX_Size() int {
+	return xxx_messageInfo_MemoryUsage.Size(m)
+}
+func (m *MemoryUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemoryUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemoryUsage proto.InternalMessageInfo
+
+func (m *MemoryUsage) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *MemoryUsage) GetAllocated() uint64 {
+	if m != nil && m.Allocated != nil {
+		return *m.Allocated
+	}
+	return 0
+}
+
+type CounterStats struct {
+	// Counter name
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Packets matching the counter
+	Packets *uint64 `protobuf:"varint,2,opt,name=packets" json:"packets,omitempty"`
+	// Bytes matching the counter
+	Bytes                *uint64  `protobuf:"varint,3,opt,name=bytes" json:"bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CounterStats) Reset()         { *m = CounterStats{} }
+func (m *CounterStats) String() string { return proto.CompactTextString(m) }
+func (*CounterStats) ProtoMessage()    {}
+func (*CounterStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_firewall_27b06245ca42c422, []int{3}
+}
+func (m *CounterStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CounterStats.Unmarshal(m, b)
+}
+func (m *CounterStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CounterStats.Marshal(b, m, deterministic)
+}
+func (dst *CounterStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CounterStats.Merge(dst, src)
+}
+func (m *CounterStats) XXX_Size() int {
+	return xxx_messageInfo_CounterStats.Size(m)
+}
+func (m *CounterStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CounterStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CounterStats proto.InternalMessageInfo
+
+func (m *CounterStats) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *CounterStats) GetPackets() uint64 {
+	if m != nil && m.Packets != nil {
+		return *m.Packets
+	}
+	return 0
+}
+
+func (m *CounterStats) GetBytes() uint64 {
+	if m != nil && m.Bytes != nil {
+		return *m.Bytes
+	}
+	return 0
+}
+
+type PolicerStats struct {
+	// Policer name
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Packets out of specification
+	OutOfSpecPackets *uint64 `protobuf:"varint,2,opt,name=out_of_spec_packets,json=outOfSpecPackets" json:"out_of_spec_packets,omitempty"`
+	// Bytes out of specification
+	OutOfSpecBytes *uint64 `protobuf:"varint,3,opt,name=out_of_spec_bytes,json=outOfSpecBytes" json:"out_of_spec_bytes,omitempty"`
+	// Extended statistics
+	ExtendedPolicerStats *ExtendedPolicerStats `protobuf:"bytes,4,opt,name=extended_policer_stats,json=extendedPolicerStats" json:"extended_policer_stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PolicerStats) Reset()         { *m = PolicerStats{} }
+func (m *PolicerStats) String() string { return proto.CompactTextString(m) }
+func (*PolicerStats) ProtoMessage()    {}
+func (*PolicerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_firewall_27b06245ca42c422, []int{4}
+}
+func (m *PolicerStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicerStats.Unmarshal(m, b)
+}
+func (m *PolicerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicerStats.Marshal(b, m, deterministic)
+}
+func (dst *PolicerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicerStats.Merge(dst, src)
+}
+func (m *PolicerStats) XXX_Size() int {
+	return xxx_messageInfo_PolicerStats.Size(m)
+}
+func (m *PolicerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicerStats proto.InternalMessageInfo
+
+func (m *PolicerStats) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *PolicerStats) GetOutOfSpecPackets() uint64 {
+	if m != nil && m.OutOfSpecPackets != nil {
+		return *m.OutOfSpecPackets
+	}
+	return 0
+}
+
+func (m *PolicerStats) GetOutOfSpecBytes() uint64 {
+	if m != nil && m.OutOfSpecBytes != nil {
+		return *m.OutOfSpecBytes
+	}
+	return 0
+}
+
+func (m *PolicerStats) GetExtendedPolicerStats() *ExtendedPolicerStats {
+	if m != nil {
+		return m.ExtendedPolicerStats
+	}
+	return nil
+}
+
+type ExtendedPolicerStats struct {
+	OfferedPackets       *uint64  `protobuf:"varint,1,opt,name=offered_packets,json=offeredPackets" json:"offered_packets,omitempty"`
+	OfferedBytes         *uint64  `protobuf:"varint,2,opt,name=offered_bytes,json=offeredBytes" json:"offered_bytes,omitempty"`
+	TransmittedPackets   *uint64  `protobuf:"varint,3,opt,name=transmitted_packets,json=transmittedPackets" json:"transmitted_packets,omitempty"`
+	TransmittedBytes     *uint64  `protobuf:"varint,4,opt,name=transmitted_bytes,json=transmittedBytes" json:"transmitted_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExtendedPolicerStats) Reset()         { *m = ExtendedPolicerStats{} }
+func (m *ExtendedPolicerStats) String() string { return proto.CompactTextString(m) }
+func (*ExtendedPolicerStats) ProtoMessage()    {}
+func (*ExtendedPolicerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_firewall_27b06245ca42c422, []int{5}
+}
+func (m *ExtendedPolicerStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtendedPolicerStats.Unmarshal(m, b)
+}
+func (m *ExtendedPolicerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtendedPolicerStats.Marshal(b, m, deterministic)
+}
+func (dst *ExtendedPolicerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendedPolicerStats.Merge(dst, src)
+}
+func (m *ExtendedPolicerStats) XXX_Size() int {
+	return xxx_messageInfo_ExtendedPolicerStats.Size(m)
+}
+func (m *ExtendedPolicerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendedPolicerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendedPolicerStats proto.InternalMessageInfo
+
+func (m *ExtendedPolicerStats) GetOfferedPackets() uint64 {
+	if m != nil && m.OfferedPackets != nil {
+		return *m.OfferedPackets
+	}
+	return 0
+}
+
+func (m *ExtendedPolicerStats) GetOfferedBytes() uint64 {
+	if m != nil && m.OfferedBytes != nil {
+		return *m.OfferedBytes
+	}
+	return 0
+}
+
+func (m *ExtendedPolicerStats) GetTransmittedPackets() uint64 {
+	if m != nil && m.TransmittedPackets != nil {
+		return *m.TransmittedPackets
+	}
+	return 0
+}
+
+func (m *ExtendedPolicerStats) GetTransmittedBytes() uint64 {
+	if m != nil && m.TransmittedBytes != nil {
+		return *m.TransmittedBytes
+	}
+	return 0
+}
+
+type HierarchicalPolicerStats struct {
+	// Policer name
+	Name                 *string  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	PremiumPackets       *uint64  `protobuf:"varint,2,opt,name=premium_packets,json=premiumPackets" json:"premium_packets,omitempty"`
+	PremiumBytes         *uint64  `protobuf:"varint,3,opt,name=premium_bytes,json=premiumBytes" json:"premium_bytes,omitempty"`
+	AggregatePackets     *uint64  `protobuf:"varint,4,opt,name=aggregate_packets,json=aggregatePackets" json:"aggregate_packets,omitempty"`
+	AggregateBytes       *uint64  `protobuf:"varint,5,opt,name=aggregate_bytes,json=aggregateBytes" json:"aggregate_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HierarchicalPolicerStats) Reset()         { *m = HierarchicalPolicerStats{} }
+func (m *HierarchicalPolicerStats) String() string { return proto.CompactTextString(m) }
+func (*HierarchicalPolicerStats) ProtoMessage()    {}
+func (*HierarchicalPolicerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_firewall_27b06245ca42c422, []int{6}
+}
+func (m *HierarchicalPolicerStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HierarchicalPolicerStats.Unmarshal(m, b)
+}
+func (m *HierarchicalPolicerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HierarchicalPolicerStats.Marshal(b, m, deterministic)
+}
+func (dst *HierarchicalPolicerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HierarchicalPolicerStats.Merge(dst, src)
+}
+func (m *HierarchicalPolicerStats) XXX_Size() int {
+	return xxx_messageInfo_HierarchicalPolicerStats.Size(m)
+}
+func (m *HierarchicalPolicerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_HierarchicalPolicerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HierarchicalPolicerStats proto.InternalMessageInfo
+
+func (m *HierarchicalPolicerStats) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *HierarchicalPolicerStats) GetPremiumPackets() uint64 {
+	if m != nil && m.PremiumPackets != nil {
+		return *m.PremiumPackets
+	}
+	return 0
+}
+
+func (m *HierarchicalPolicerStats) GetPremiumBytes() uint64 {
+	if m != nil && m.PremiumBytes != nil {
+		return *m.PremiumBytes
+	}
+	return 0
+}
+
+func (m *HierarchicalPolicerStats) GetAggregatePackets() uint64 {
+	if m != nil && m.AggregatePackets != nil {
+		return *m.AggregatePackets
+	}
+	return 0
+}
+
+func (m *HierarchicalPolicerStats) GetAggregateBytes() uint64 {
+	if m != nil && m.AggregateBytes != nil {
+		return *m.AggregateBytes
+	}
+	return 0
+}
+
+var E_JnprFirewallExt = &proto.ExtensionDesc{
+	ExtendedType:  (*JuniperNetworksSensors)(nil),
+	ExtensionType: (*Firewall)(nil),
+	Field:         6,
+	Name:          "jnpr_firewall_ext",
+	Tag:           "bytes,6,opt,name=jnpr_firewall_ext,json=jnprFirewallExt",
+	Filename:      "firewall.proto",
+}
+
+func init() {
+	proto.RegisterType((*Firewall)(nil), "Firewall")
+	proto.RegisterType((*FirewallStats)(nil), "FirewallStats")
+	proto.RegisterType((*MemoryUsage)(nil), "MemoryUsage")
+	proto.RegisterType((*CounterStats)(nil), "CounterStats")
+	proto.RegisterType((*PolicerStats)(nil), "PolicerStats")
+	proto.RegisterType((*ExtendedPolicerStats)(nil), "ExtendedPolicerStats")
+	proto.RegisterType((*HierarchicalPolicerStats)(nil), "HierarchicalPolicerStats")
+	proto.RegisterExtension(E_JnprFirewallExt)
+}
+
+func init() { proto.RegisterFile("firewall.proto", fileDescriptor_firewall_27b06245ca42c422) }
+
+var fileDescriptor_firewall_27b06245ca42c422 = []byte{
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x51, 0x8b, 0xd3, 0x4c,
+	0x14, 0x25, 0xdb, 0xf4, 0xfb, 0xec, 0x6d, 0xda, 0xda, 0x69, 0xd5, 0xb8, 0x08, 0x96, 0xf8, 0xd0,
+	0xca, 0x62, 0x16, 0x0a, 0xbe, 0xf8, 0x22, 0xae, 0xac, 0x88, 0x62, 0x5d, 0x52, 0x44, 0xf0, 0x25,
+	0x8c, 0xe9, 0x4d, 0x9b, 0xdd, 0x24, 0x13, 0x26, 0x13, 0xb6, 0xfd, 0x77, 0xe2, 0x9b, 0xaf, 0xfe,
+	0x22, 0x49, 0x66, 0x92, 0x4e, 0x97, 0xee, 0xe3, 0x3d, 0xf7, 0x9c, 0x73, 0xcf, 0xbd, 0xd3, 0x06,
+	0xfa, 0x61, 0xc4, 0xf1, 0x96, 0xc6, 0xb1, 0x9b, 0x71, 0x26, 0xd8, 0xe9, 0x48, 0x60, 0x8c, 0x09,
+	0x0a, 0xbe, 0xf3, 0x05, 0xcb, 0x24, 0xe8, 0xbc, 0x83, 0x07, 0x1f, 0x14, 0x8d, 0xbc, 0xde, 0x4b,
+	0xfc, 0x5c, 0x50, 0x91, 0xdb, 0xc6, 0xa4, 0x35, 0xeb, 0xce, 0xfb, 0x6e, 0x4d, 0x59, 0x96, 0xa8,
+	0xd7, 0x0b, 0xf5, 0xd2, 0xf9, 0x75, 0x02, 0xbd, 0x03, 0x02, 0x79, 0x0e, 0xdd, 0x30, 0x8a, 0x05,
+	0x72, 0x3f, 0xa5, 0x09, 0xda, 0xc6, 0xc4, 0x98, 0x75, 0x3c, 0x90, 0xd0, 0x82, 0x26, 0x48, 0x9e,
+	0x41, 0x47, 0x44, 0x09, 0xe6, 0x82, 0x26, 0x99, 0x7d, 0x32, 0x31, 0x66, 0xa6, 0xb7, 0x07, 0xc8,
+	0x39, 0x58, 0x09, 0x26, 0x8c, 0xef, 0xfc, 0x22, 0xa7, 0x6b, 0xb4, 0x5b, 0x55, 0x0a, 0xcb, 0xfd,
+	0x52, 0x81, 0xdf, 0x4a, 0xcc, 0xeb, 0x26, 0xfb, 0x82, 0xcc, 0xa1, 0x17, 0xb0, 0x22, 0x2d, 0x07,
+	0xca, 0xdc, 0x66, 0xa5, 0xe8, 0xb9, 0xef, 0x25, 0x2a, 0x63, 0x5b, 0x81, 0x56, 0x95, 0x9a, 0x8c,
+	0xc5, 0x51, 0xd0, 0x68, 0xda, 0x4a, 0x73, 0x25, 0x51, 0xa5, 0xc9, 0xb4, 0x8a, 0x7c, 0x87, 0xd3,
+	0x4d, 0x84, 0x9c, 0xf2, 0x60, 0x13, 0x05, 0x34, 0xf6, 0x0f, 0x0d, 0xfe, 0xab, 0x0c, 0x9e, 0xba,
+	0x1f, 0x35, 0xca, 0x81, 0x99, 0xbd, 0xb9, 0xa7, 0xe3, 0xbc, 0x85, 0xae, 0xb6, 0x1c, 0x21, 0x60,
+	0x6a, 0x87, 0x33, 0x53, 0x75, 0x32, 0x1a, 0xc7, 0x2c, 0xa0, 0x02, 0x57, 0xf5, 0xc9, 0x1a, 0xc0,
+	0xf1, 0xc0, 0xd2, 0x77, 0x3d, 0xea, 0x60, 0xc3, 0xff, 0x19, 0x0d, 0x6e, 0x50, 0xe4, 0x4a, 0x5f,
+	0x97, 0x64, 0x0c, 0xed, 0x9f, 0x3b, 0x81, 0xb9, 0xdd, 0xaa, 0x70, 0x59, 0x38, 0x7f, 0x0c, 0xb0,
+	0xf4, 0x94, 0x47, 0x4d, 0x5f, 0xc1, 0x88, 0x15, 0xc2, 0x67, 0xa1, 0x9f, 0x67, 0x18, 0xf8, 0x87,
+	0x03, 0x1e, 0xb2, 0x42, 0x7c, 0x0d, 0x97, 0x19, 0x06, 0x57, 0x6a, 0xd2, 0x4b, 0x18, 0xea, 0x74,
+	0x7d, 0x6a, 0xbf, 0x21, 0x5f, 0x94, 0x28, 0xf9, 0x0c, 0x8f, 0x71, 0x2b, 0x30, 0x5d, 0xe1, 0xea,
+	0xce, 0xa1, 0xcd, 0x89, 0x31, 0xeb, 0xce, 0x1f, 0xb9, 0x97, 0xaa, 0x7d, 0x70, 0xe4, 0x31, 0x1e,
+	0x41, 0x9d, 0xdf, 0x06, 0x8c, 0x8f, 0xd1, 0xc9, 0x14, 0x06, 0x2c, 0x0c, 0x91, 0x97, 0x43, 0x54,
+	0x76, 0x43, 0xc5, 0x91, 0x70, 0x9d, 0xfc, 0x05, 0xf4, 0x6a, 0xa2, 0x4c, 0x2d, 0x57, 0xb4, 0x14,
+	0x28, 0x33, 0x9f, 0xc3, 0x48, 0x70, 0x9a, 0xe6, 0x49, 0x24, 0x84, 0xe6, 0x28, 0x17, 0x24, 0x5a,
+	0xab, 0x76, 0x3d, 0x83, 0xa1, 0x2e, 0x90, 0xce, 0xa6, 0x3c, 0x9e, 0xd6, 0xa8, 0xdc, 0x9d, 0xbf,
+	0x06, 0xd8, 0xf7, 0xfd, 0xb8, 0x8e, 0x3e, 0xce, 0x14, 0x06, 0x19, 0xc7, 0x24, 0x2a, 0x92, 0x3b,
+	0x0f, 0xd3, 0x57, 0xb0, 0xb6, 0x5c, 0x4d, 0xd4, 0x9f, 0xc4, 0x52, 0xa0, 0x5c, 0xee, 0x0c, 0x86,
+	0x74, 0xbd, 0xe6, 0xb8, 0xa6, 0x02, 0x1b, 0x3f, 0x95, 0xb5, 0x69, 0xd4, 0x8e, 0x53, 0x18, 0xec,
+	0xc9, 0xd2, 0xb3, 0x2d, 0x47, 0x37, 0x70, 0xe5, 0xfa, 0x66, 0x01, 0xc3, 0xeb, 0x34, 0xe3, 0x7e,
+	0xf3, 0xe5, 0xc1, 0xad, 0x20, 0x4f, 0xdc, 0x4f, 0x45, 0x1a, 0x65, 0xc8, 0x17, 0x28, 0x6e, 0x19,
+	0xbf, 0xc9, 0x97, 0x98, 0xe6, 0x8c, 0x97, 0xff, 0xb1, 0xf2, 0xe9, 0x3b, 0xcd, 0x07, 0xc9, 0x1b,
+	0x94, 0xe2, 0xba, 0xba, 0xdc, 0x8a, 0x8b, 0xf6, 0x8f, 0xd6, 0xb5, 0x88, 0xfe, 0x0d, 0x00, 0xe8,
+	0x4e, 0xde, 0xfc, 0xfd, 0x04, 0x00, 0x00,
+}
@@ -0,0 +1,694 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: logical_port.proto
+
+package jti
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Top-level message
+type LogicalPort struct {
+	InterfaceInfo        []*LogicalInterfaceInfo `protobuf:"bytes,1,rep,name=interface_info,json=interfaceInfo" json:"interface_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *LogicalPort) Reset()         { *m = LogicalPort{} }
+func (m *LogicalPort) String() string { return proto.CompactTextString(m) }
+func (*LogicalPort) ProtoMessage()    {}
+func (*LogicalPort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_logical_port_53619fad1d9d8e25, []int{0}
+}
+func (m *LogicalPort) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogicalPort.Unmarshal(m, b)
+}
+func (m *LogicalPort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogicalPort.Marshal(b, m, deterministic)
+}
+func (dst *LogicalPort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogicalPort.Merge(dst, src)
+}
+func (m *LogicalPort) XXX_Size() int {
+	return xxx_messageInfo_LogicalPort.Size(m)
+}
+func (m *LogicalPort) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogicalPort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogicalPort proto.InternalMessageInfo
+
+func (m *LogicalPort) GetInterfaceInfo() []*LogicalInterfaceInfo {
+	if m != nil {
+		return m.InterfaceInfo
+	}
+	return nil
+}
+
+// Logical Interface information
+type LogicalInterfaceInfo struct {
+	// Logical interface name, e.g., xe-0/0/0.0
+	IfName *string `protobuf:"bytes,1,opt,name=if_name,json=ifName" json:"if_name,omitempty"`
+	// Time when interface is created
+	InitTime *uint64 `protobuf:"varint,2,opt,name=init_time,json=initTime" json:"init_time,omitempty"`
+	// Global Index
+	SnmpIfIndex *uint32 `protobuf:"varint,3,opt,name=snmp_if_index,json=snmpIfIndex" json:"snmp_if_index,omitempty"`
+	// Name of parent for AE interface, if applicable
+	ParentAeName *string `protobuf:"bytes,4,opt,name=parent_ae_name,json=parentAeName" json:"parent_ae_name,omitempty"`
+	// Inbound traffic statistics
+	IngressStats *IngressInterfaceStats `protobuf:"bytes,5,opt,name=ingress_stats,json=ingressStats" json:"ingress_stats,omitempty"`
+	// Outbound traffic statistics
+	EgressStats *EgressInterfaceStats `protobuf:"bytes,6,opt,name=egress_stats,json=egressStats" json:"egress_stats,omitempty"`
+	// Interface operational status
+	OpState *OperationalState `protobuf:"bytes,7,opt,name=op_state,json=opState" json:"op_state,omitempty"`
+	// Administrative status
+	AdministrativeStatus *string `protobuf:"bytes,8,opt,name=administrative_status,json=administrativeStatus" json:"administrative_status,omitempty"`
+	// Description of the logical interface
+	Description *string `protobuf:"bytes,9,opt,name=description" json:"description,omitempty"`
+	// This corresponds to the ifLastChange object in the standard interface MIB
+	LastChange *uint32 `protobuf:"varint,10,opt,name=last_change,json=lastChange" json:"last_change,omitempty"`
+	// This corresponds to the ifHighSpeed object in the standard interface MIB
+	HighSpeed *uint32 `protobuf:"varint,11,opt,name=high_speed,json=highSpeed" json:"high_speed,omitempty"`
+	// Inbound queue information
+	IngressQueueInfo []*LogicalInterfaceQueueStats `protobuf:"bytes,12,rep,name=ingress_queue_info,json=ingressQueueInfo" json:"ingress_queue_info,omitempty"`
+	// Outbound queue information
+	EgressQueueInfo      []*LogicalInterfaceQueueStats `protobuf:"bytes,13,rep,name=egress_queue_info,json=egressQueueInfo" json:"egress_queue_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *LogicalInterfaceInfo) Reset()         { *m = LogicalInterfaceInfo{} }
+func (m *LogicalInterfaceInfo) String() string { return proto.CompactTextString(m) }
+func (*LogicalInterfaceInfo) ProtoMessage()    {}
+func (*LogicalInterfaceInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_logical_port_53619fad1d9d8e25, []int{1}
+}
+func (m *LogicalInterfaceInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogicalInterfaceInfo.Unmarshal(m, b)
+}
+func (m *LogicalInterfaceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogicalInterfaceInfo.Marshal(b, m, deterministic)
+}
+func (dst *LogicalInterfaceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogicalInterfaceInfo.Merge(dst, src)
+}
+func (m *LogicalInterfaceInfo) XXX_Size() int {
+	return xxx_messageInfo_LogicalInterfaceInfo.Size(m)
+}
+func (m *LogicalInterfaceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogicalInterfaceInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogicalInterfaceInfo proto.InternalMessageInfo
+
+func (m *LogicalInterfaceInfo) GetIfName() string {
+	if m != nil && m.IfName != nil {
+		return *m.IfName
+	}
+	return ""
+}
+
+func (m *LogicalInterfaceInfo) GetInitTime() uint64 {
+	if m != nil && m.InitTime != nil {
+		return *m.InitTime
+	}
+	return 0
+}
+
+func (m *LogicalInterfaceInfo) GetSnmpIfIndex() uint32 {
+	if m != nil && m.SnmpIfIndex != nil {
+		return *m.SnmpIfIndex
+	}
+	return 0
+}
+
+func (m *LogicalInterfaceInfo) GetParentAeName() string {
+	if m != nil && m.ParentAeName != nil {
+		return *m.ParentAeName
+	}
+	return ""
+}
+
+func (m *LogicalInterfaceInfo) GetIngressStats() *IngressInterfaceStats {
+	if m != nil {
+		return m.IngressStats
+	}
+	return nil
+}
+
+func (m *LogicalInterfaceInfo) GetEgressStats() *EgressInterfaceStats {
+	if m != nil {
+		return m.EgressStats
+	}
+	return nil
+}
+
+func (m *LogicalInterfaceInfo) GetOpState() *OperationalState {
+	if m != nil {
+		return m.OpState
+	}
+	return nil
+}
+
+func (m *LogicalInterfaceInfo) GetAdministrativeStatus() string {
+	if m != nil && m.AdministrativeStatus != nil {
+		return *m.AdministrativeStatus
+	}
+	return ""
+}
+
+func (m *LogicalInterfaceInfo) GetDescription() string {
+	if m != nil && m.Description != nil {
+		return *m.Description
+	}
+	return ""
+}
+
+func (m *LogicalInterfaceInfo) GetLastChange() uint32 {
+	if m != nil && m.LastChange != nil {
+		return *m.LastChange
+	}
+	return 0
+}
+
+func (m *LogicalInterfaceInfo) GetHighSpeed() uint32 {
+	if m != nil && m.HighSpeed != nil {
+		return *m.HighSpeed
+	}
+	return 0
+}
+
+func (m *LogicalInterfaceInfo) GetIngressQueueInfo() []*LogicalInterfaceQueueStats {
+	if m != nil {
+		return m.IngressQueueInfo
+	}
+	return nil
+}
+
+func (m *LogicalInterfaceInfo) GetEgressQueueInfo() []*LogicalInterfaceQueueStats {
+	if m != nil {
+		return m.EgressQueueInfo
+	}
+	return nil
+}
+
+// Interface inbound/Ingress traffic statistics
+type IngressInterfaceStats struct {
+	// Counter: the total number of packets received by this interface
+	IfPackets *uint64 `protobuf:"varint,1,opt,name=if_packets,json=ifPackets" json:"if_packets,omitempty"`
+	// Counter: the total number of bytes received by this interface
+	IfOctets *uint64 `protobuf:"varint,2,opt,name=if_octets,json=ifOctets" json:"if_octets,omitempty"`
+	// Counter: the total number of unicast packets received by this interface
+	IfUcastPackets *uint64 `protobuf:"varint,3,opt,name=if_ucast_packets,json=ifUcastPackets" json:"if_ucast_packets,omitempty"`
+	// Counter: the total number of multicast packets received by this interface
+	IfMcastPackets *uint64 `protobuf:"varint,4,opt,name=if_mcast_packets,json=ifMcastPackets" json:"if_mcast_packets,omitempty"`
+	// Counters: per family accounting
+	IfFcStats            []*ForwardingClassAccounting `protobuf:"bytes,5,rep,name=if_fc_stats,json=ifFcStats" json:"if_fc_stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *IngressInterfaceStats) Reset()         { *m = IngressInterfaceStats{} }
+func (m *IngressInterfaceStats) String() string { return proto.CompactTextString(m) }
+func (*IngressInterfaceStats) ProtoMessage()    {}
+func (*IngressInterfaceStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_logical_port_53619fad1d9d8e25, []int{2}
+}
+func (m *IngressInterfaceStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IngressInterfaceStats.Unmarshal(m, b)
+}
+func (m *IngressInterfaceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IngressInterfaceStats.Marshal(b, m, deterministic)
+}
+func (dst *IngressInterfaceStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IngressInterfaceStats.Merge(dst, src)
+}
+func (m *IngressInterfaceStats) XXX_Size() int {
+	return xxx_messageInfo_IngressInterfaceStats.Size(m)
+}
+func (m *IngressInterfaceStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_IngressInterfaceStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IngressInterfaceStats proto.InternalMessageInfo
+
+func (m *IngressInterfaceStats) GetIfPackets() uint64 {
+	if m != nil && m.IfPackets != nil {
+		return *m.IfPackets
+	}
+	return 0
+}
+
+func (m *IngressInterfaceStats) GetIfOctets() uint64 {
+	if m != nil && m.IfOctets != nil {
+		return *m.IfOctets
+	}
+	return 0
+}
+
+func (m *IngressInterfaceStats) GetIfUcastPackets() uint64 {
+	if m != nil && m.IfUcastPackets != nil {
+		return *m.IfUcastPackets
+	}
+	return 0
+}
+
+func (m *IngressInterfaceStats) GetIfMcastPackets() uint64 {
+	if m != nil && m.IfMcastPackets != nil {
+		return *m.IfMcastPackets
+	}
+	return 0
+}
+
+func (m *IngressInterfaceStats) GetIfFcStats() []*ForwardingClassAccounting {
+	if m != nil {
+		return m.IfFcStats
+	}
+	return nil
+}
+
+// Interface outbound/egress traffic statistics
+type EgressInterfaceStats struct {
+	// Counter: the total number of packets sent by this interface
+	IfPackets *uint64 `protobuf:"varint,1,opt,name=if_packets,json=ifPackets" json:"if_packets,omitempty"`
+	// Counter: the total number of bytes sent by this interface
+	IfOctets *uint64 `protobuf:"varint,2,opt,name=if_octets,json=ifOctets" json:"if_octets,omitempty"`
+	// Counter: the total number of unicast packets sent by this interface
+	IfUcastPackets *uint64 `protobuf:"varint,3,opt,name=if_ucast_packets,json=ifUcastPackets" json:"if_ucast_packets,omitempty"`
+	// Counter: the total number of multicast packets sent by this interface
+	IfMcastPackets       *uint64  `protobuf:"varint,4,opt,name=if_mcast_packets,json=ifMcastPackets" json:"if_mcast_packets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EgressInterfaceStats) Reset()         { *m = EgressInterfaceStats{} }
+func (m *EgressInterfaceStats) String() string { return proto.CompactTextString(m) }
+func (*EgressInterfaceStats) ProtoMessage()    {}
+func (*EgressInterfaceStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_logical_port_53619fad1d9d8e25, []int{3}
+}
+func (m *EgressInterfaceStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EgressInterfaceStats.Unmarshal(m, b)
+}
+func (m *EgressInterfaceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EgressInterfaceStats.Marshal(b, m, deterministic)
+}
+func (dst *EgressInterfaceStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressInterfaceStats.Merge(dst, src)
+}
+func (m *EgressInterfaceStats) XXX_Size() int {
+	return xxx_messageInfo_EgressInterfaceStats.Size(m)
+}
+func (m *EgressInterfaceStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressInterfaceStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EgressInterfaceStats proto.InternalMessageInfo
+
+func (m *EgressInterfaceStats) GetIfPackets() uint64 {
+	if m != nil && m.IfPackets != nil {
+		return *m.IfPackets
+	}
+	return 0
+}
+
+func (m *EgressInterfaceStats) GetIfOctets() uint64 {
+	if m != nil && m.IfOctets != nil {
+		return *m.IfOctets
+	}
+	return 0
+}
+
+func (m *EgressInterfaceStats) GetIfUcastPackets() uint64 {
+	if m != nil && m.IfUcastPackets != nil {
+		return *m.IfUcastPackets
+	}
+	return 0
+}
+
+func (m *EgressInterfaceStats) GetIfMcastPackets() uint64 {
+	if m != nil && m.IfMcastPackets != nil {
+		return *m.IfMcastPackets
+	}
+	return 0
+}
+
+// Interface operational state
+type OperationalState struct {
+	// Interface operational status, up or down
+	OperationalStatus    *string  `protobuf:"bytes,1,opt,name=operational_status,json=operationalStatus" json:"operational_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OperationalState) Reset()         { *m = OperationalState{} }
+func (m *OperationalState) String() string { return proto.CompactTextString(m) }
+func (*OperationalState) ProtoMessage()    {}
+func (*OperationalState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_logical_port_53619fad1d9d8e25, []int{4}
+}
+func (m *OperationalState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperationalState.Unmarshal(m, b)
+}
+func (m *OperationalState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperationalState.Marshal(b, m, deterministic)
+}
+func (dst *OperationalState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationalState.Merge(dst, src)
+}
+func (m *OperationalState) XXX_Size() int {
+	return xxx_messageInfo_OperationalState.Size(m)
+}
+func (m *OperationalState) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationalState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationalState proto.InternalMessageInfo
+
+func (m *OperationalState) GetOperationalStatus() string {
+	if m != nil && m.OperationalStatus != nil {
+		return *m.OperationalStatus
+	}
+	return ""
+}
+
+// Per family accounting
+type ForwardingClassAccounting struct {
+	// Address family, e.g. inet, inet6, mpls
+	IfFamily *string `protobuf:"bytes,1,opt,name=if_family,json=ifFamily" json:"if_family,omitempty"`
+	// Forwarding class number
+	FcNumber *uint32 `protobuf:"varint,2,opt,name=fc_number,json=fcNumber" json:"fc_number,omitempty"`
+	// Counter: packets
+	IfPackets *uint64 `protobuf:"varint,3,opt,name=if_packets,json=ifPackets" json:"if_packets,omitempty"`
+	// Counter: bytes
+	IfOctets *uint64 `protobuf:"varint,4,opt,name=if_octets,json=ifOctets" json:"if_octets,omitempty"`
+	// Counter: IPv6 packets
+	IfV6Packets *uint64 `protobuf:"varint,5,opt,name=if_v6_packets,json=ifV6Packets" json:"if_v6_packets,omitempty"`
+	// Counter: IPv6 bytes
+	IfV6Octets           *uint64  `protobuf:"varint,6,opt,name=if_v6_octets,json=ifV6Octets" json:"if_v6_octets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForwardingClassAccounting) Reset()         { *m = ForwardingClassAccounting{} }
+func (m *ForwardingClassAccounting) String() string { return proto.CompactTextString(m) }
+func (*ForwardingClassAccounting) ProtoMessage()    {}
+func (*ForwardingClassAccounting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_logical_port_53619fad1d9d8e25, []int{5}
+}
+func (m *ForwardingClassAccounting) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingClassAccounting.Unmarshal(m, b)
+}
+func (m *ForwardingClassAccounting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingClassAccounting.Marshal(b, m, deterministic)
+}
+func (dst *ForwardingClassAccounting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingClassAccounting.Merge(dst, src)
+}
+func (m *ForwardingClassAccounting) XXX_Size() int {
+	return xxx_messageInfo_ForwardingClassAccounting.Size(m)
+}
+func (m *ForwardingClassAccounting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingClassAccounting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardingClassAccounting proto.InternalMessageInfo
+
+func (m *ForwardingClassAccounting) GetIfFamily() string {
+	if m != nil && m.IfFamily != nil {
+		return *m.IfFamily
+	}
+	return ""
+}
+
+func (m *ForwardingClassAccounting) GetFcNumber() uint32 {
+	if m != nil && m.FcNumber != nil {
+		return *m.FcNumber
+	}
+	return 0
+}
+
+func (m *ForwardingClassAccounting) GetIfPackets() uint64 {
+	if m != nil && m.IfPackets != nil {
+		return *m.IfPackets
+	}
+	return 0
+}
+
+func (m *ForwardingClassAccounting) GetIfOctets() uint64 {
+	if m != nil && m.IfOctets != nil {
+		return *m.IfOctets
+	}
+	return 0
+}
+
+func (m *ForwardingClassAccounting) GetIfV6Packets() uint64 {
+	if m != nil && m.IfV6Packets != nil {
+		return *m.IfV6Packets
+	}
+	return 0
+}
+
+func (m *ForwardingClassAccounting) GetIfV6Octets() uint64 {
+	if m != nil && m.IfV6Octets != nil {
+		return *m.IfV6Octets
+	}
+	return 0
+}
+
+// Logical interface queue statistics
+type LogicalInterfaceQueueStats struct {
+	// Queue number
+	QueueNumber *uint32 `protobuf:"varint,1,opt,name=queue_number,json=queueNumber" json:"queue_number,omitempty"`
+	// The total number of packets that have been added to this queue
+	Packets *uint64 `protobuf:"varint,2,opt,name=packets" json:"packets,omitempty"`
+	// The total number of bytes that have been added to this queue
+	Bytes *uint64 `protobuf:"varint,3,opt,name=bytes" json:"bytes,omitempty"`
+	// The total number of tail dropped packets
+	TailDropPackets *uint64 `protobuf:"varint,4,opt,name=tail_drop_packets,json=tailDropPackets" json:"tail_drop_packets,omitempty"`
+	// The total number of rate-limited packets
+	RlDropPackets *uint64 `protobuf:"varint,5,opt,name=rl_drop_packets,json=rlDropPackets" json:"rl_drop_packets,omitempty"`
+	// The total number of rate-limited bytes
+	RlDropBytes *uint64 `protobuf:"varint,6,opt,name=rl_drop_bytes,json=rlDropBytes" json:"rl_drop_bytes,omitempty"`
+	// The total number of red-dropped packets
+	RedDropPackets *uint64 `protobuf:"varint,7,opt,name=red_drop_packets,json=redDropPackets" json:"red_drop_packets,omitempty"`
+	// The total number of red-dropped bytes
+	RedDropBytes *uint64 `protobuf:"varint,8,opt,name=red_drop_bytes,json=redDropBytes" json:"red_drop_bytes,omitempty"`
+	// Average queue depth, in packets
+	AvgBufferOccupancy *uint64 `protobuf:"varint,9,opt,name=avg_buffer_occupancy,json=avgBufferOccupancy" json:"avg_buffer_occupancy,omitempty"`
+	// Current queue depth, in packets
+	CurBufferOccupancy *uint64 `protobuf:"varint,10,opt,name=cur_buffer_occupancy,json=curBufferOccupancy" json:"cur_buffer_occupancy,omitempty"`
+	// The max measured queue depth, in packets, across all measurements since boot
+	PeakBufferOccupancy *uint64 `protobuf:"varint,11,opt,name=peak_buffer_occupancy,json=peakBufferOccupancy" json:"peak_buffer_occupancy,omitempty"`
+	// Allocated buffer size
+	AllocatedBufferSize  *uint64  `protobuf:"varint,12,opt,name=allocated_buffer_size,json=allocatedBufferSize" json:"allocated_buffer_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogicalInterfaceQueueStats) Reset()         { *m = LogicalInterfaceQueueStats{} }
+func (m *LogicalInterfaceQueueStats) String() string { return proto.CompactTextString(m) }
+func (*LogicalInterfaceQueueStats) ProtoMessage()    {}
+func (*LogicalInterfaceQueueStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_logical_port_53619fad1d9d8e25, []int{6}
+}
+func (m *LogicalInterfaceQueueStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogicalInterfaceQueueStats.Unmarshal(m, b)
+}
+func (m *LogicalInterfaceQueueStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogicalInterfaceQueueStats.Marshal(b, m, deterministic)
+}
+func (dst *LogicalInterfaceQueueStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogicalInterfaceQueueStats.Merge(dst, src)
+}
+func (m *LogicalInterfaceQueueStats) XXX_Size() int {
+	return xxx_messageInfo_LogicalInterfaceQueueStats.Size(m)
+}
+func (m *LogicalInterfaceQueueStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogicalInterfaceQueueStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogicalInterfaceQueueStats proto.InternalMessageInfo
+
+func (m *LogicalInterfaceQueueStats) GetQueueNumber() uint32 {
+	if m != nil && m.QueueNumber != nil {
+		return *m.QueueNumber
+	}
+	return 0
+}
+
+func (m *LogicalInterfaceQueueStats) GetPackets() uint64 {
+	if m != nil && m.Packets != nil {
+		return *m.Packets
+	}
+	return 0
+}
+
+func (m *LogicalInterfaceQueueStats) GetBytes() uint64 {
+	if m != nil && m.Bytes != nil {
+		return *m.Bytes
+	}
+	return 0
+}
+
+func (m *LogicalInterfaceQueueStats) GetTailDropPackets() uint64 {
+	if m != nil && m.TailDropPackets != nil {
+		return *m.TailDropPackets
+	}
+	return 0
+}
+
+func (m *LogicalInterfaceQueueStats) GetRlDropPackets() uint64 {
+	if m != nil && m.RlDropPackets != nil {
+		return *m.RlDropPackets
+	}
+	return 0
+}
+
+func (m *LogicalInterfaceQueueStats) GetRlDropBytes() uint64 {
+	if m != nil && m.RlDropBytes != nil {
+		return *m.RlDropBytes
+	}
+	return 0
+}
+
+func (m *LogicalInterfaceQueueStats) GetRedDropPackets() uint64 {
+	if m != nil && m.RedDropPackets != nil {
+		return *m.RedDropPackets
+	}
+	return 0
+}
+
+func (m *LogicalInterfaceQueueStats) GetRedDropBytes() uint64 {
+	if m != nil && m.RedDropBytes != nil {
+		return *m.RedDropBytes
+	}
+	return 0
+}
+
+func (m *LogicalInterfaceQueueStats) GetAvgBufferOccupancy() uint64 {
+	if m != nil && m.AvgBufferOccupancy != nil {
+		return *m.AvgBufferOccupancy
+	}
+	return 0
+}
+
+func (m *LogicalInterfaceQueueStats) GetCurBufferOccupancy() uint64 {
+	if m != nil && m.CurBufferOccupancy != nil {
+		return *m.CurBufferOccupancy
+	}
+	return 0
+}
+
+func (m *LogicalInterfaceQueueStats) GetPeakBufferOccupancy() uint64 {
+	if m != nil && m.PeakBufferOccupancy != nil {
+		return *m.PeakBufferOccupancy
+	}
+	return 0
+}
+
+func (m *LogicalInterfaceQueueStats) GetAllocatedBufferSize() uint64 {
+	if m != nil && m.AllocatedBufferSize != nil {
+		return *m.AllocatedBufferSize
+	}
+	return 0
+}
+
+var E_JnprLogicalInterfaceExt = &proto.ExtensionDesc{
+	ExtendedType:  (*JuniperNetworksSensors)(nil),
+	ExtensionType: (*LogicalPort)(nil),
+	Field:         7,
+	Name:          "jnprLogicalInterfaceExt",
+	Tag:           "bytes,7,opt,name=jnprLogicalInterfaceExt",
+	Filename:      "logical_port.proto",
+}
+
+func init() {
+	proto.RegisterType((*LogicalPort)(nil), "LogicalPort")
+	proto.RegisterType((*LogicalInterfaceInfo)(nil), "LogicalInterfaceInfo")
+	proto.RegisterType((*IngressInterfaceStats)(nil), "IngressInterfaceStats")
+	proto.RegisterType((*EgressInterfaceStats)(nil), "EgressInterfaceStats")
+	proto.RegisterType((*OperationalState)(nil), "OperationalState")
+	proto.RegisterType((*ForwardingClassAccounting)(nil), "ForwardingClassAccounting")
+	proto.RegisterType((*LogicalInterfaceQueueStats)(nil), "LogicalInterfaceQueueStats")
+	proto.RegisterExtension(E_JnprLogicalInterfaceExt)
+}
+
+func init() { proto.RegisterFile("logical_port.proto", fileDescriptor_logical_port_53619fad1d9d8e25) }
+
+var fileDescriptor_logical_port_53619fad1d9d8e25 = []byte{
+	// 872 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x5d, 0x6f, 0xe3, 0x44,
+	0x14, 0x95, 0x69, 0xda, 0x26, 0xd7, 0x49, 0x3f, 0x66, 0x13, 0x6a, 0xba, 0x42, 0x04, 0x6b, 0x85,
+	0x22, 0x04, 0x11, 0x2a, 0xd2, 0x0a, 0x2d, 0xbc, 0xb4, 0x4b, 0x8b, 0xc2, 0x47, 0xbb, 0x38, 0xc0,
+	0x03, 0x2f, 0xa3, 0xa9, 0x73, 0x27, 0x9d, 0xad, 0x3d, 0x63, 0xc6, 0xe3, 0xee, 0x76, 0x5f, 0xf9,
+	0x2f, 0xfc, 0x25, 0x7e, 0x00, 0x12, 0xbf, 0x03, 0xcd, 0x8c, 0x1d, 0x92, 0xb4, 0x85, 0xe7, 0x7d,
+	0xcb, 0x3d, 0xf7, 0x9c, 0x33, 0x73, 0xaf, 0x4f, 0x6c, 0x20, 0x99, 0x9a, 0x8b, 0x94, 0x65, 0xb4,
+	0x50, 0xda, 0x8c, 0x0b, 0xad, 0x8c, 0x3a, 0x7c, 0x64, 0x30, 0xc3, 0x1c, 0x8d, 0xbe, 0xa5, 0x46,
+	0x15, 0x1e, 0x8c, 0xbf, 0x83, 0xf0, 0x7b, 0x4f, 0x7d, 0xa1, 0xb4, 0x21, 0x5f, 0xc1, 0x8e, 0x90,
+	0x06, 0x35, 0x67, 0x29, 0x52, 0x21, 0xb9, 0x8a, 0x82, 0xe1, 0xc6, 0x28, 0x3c, 0x1a, 0x8c, 0x6b,
+	0xd6, 0xa4, 0xe9, 0x4e, 0x24, 0x57, 0x49, 0x4f, 0x2c, 0x97, 0xf1, 0xdf, 0x2d, 0xe8, 0xdf, 0xc7,
+	0x23, 0x07, 0xb0, 0x2d, 0x38, 0x95, 0x2c, 0xc7, 0x28, 0x18, 0x06, 0xa3, 0x4e, 0xb2, 0x25, 0xf8,
+	0x39, 0xcb, 0x91, 0x3c, 0x86, 0x8e, 0x90, 0xc2, 0x50, 0x23, 0x72, 0x8c, 0xde, 0x19, 0x06, 0xa3,
+	0x56, 0xd2, 0xb6, 0xc0, 0x4f, 0x22, 0x47, 0x12, 0x43, 0xaf, 0x94, 0x79, 0x41, 0x05, 0xa7, 0x42,
+	0xce, 0xf0, 0x75, 0xb4, 0x31, 0x0c, 0x46, 0xbd, 0x24, 0xb4, 0xe0, 0x84, 0x4f, 0x2c, 0x44, 0x9e,
+	0xc0, 0x4e, 0xc1, 0x34, 0x4a, 0x43, 0x19, 0xfa, 0x03, 0x5a, 0xee, 0x80, 0xae, 0x47, 0x8f, 0xd1,
+	0x1d, 0xf3, 0x25, 0xf4, 0x84, 0x9c, 0x6b, 0x2c, 0x4b, 0x5a, 0x1a, 0x66, 0xca, 0x68, 0x73, 0x18,
+	0x8c, 0xc2, 0xa3, 0x77, 0xc7, 0x13, 0x8f, 0x2e, 0x6e, 0x3b, 0xb5, 0xdd, 0xa4, 0x5b, 0x93, 0x5d,
+	0x45, 0xbe, 0x80, 0x2e, 0x2e, 0x6b, 0xb7, 0x9c, 0x76, 0x30, 0x3e, 0xbd, 0x4f, 0x1a, 0xe2, 0x92,
+	0xf2, 0x13, 0x68, 0xab, 0xc2, 0xa9, 0x30, 0xda, 0x76, 0xaa, 0xfd, 0xf1, 0x45, 0x81, 0x9a, 0x19,
+	0xa1, 0x24, 0xcb, 0x2c, 0x09, 0x93, 0x6d, 0x55, 0xb8, 0x1f, 0xe4, 0x73, 0x18, 0xb0, 0x59, 0x2e,
+	0xa4, 0x28, 0x8d, 0x65, 0xdc, 0xa0, 0x53, 0x56, 0x65, 0xd4, 0x76, 0x13, 0xf5, 0x57, 0x9b, 0x53,
+	0xd7, 0x23, 0x43, 0x08, 0x67, 0x58, 0xa6, 0x5a, 0x14, 0xd6, 0x33, 0xea, 0x38, 0xea, 0x32, 0x44,
+	0x3e, 0x80, 0x30, 0x63, 0xa5, 0xa1, 0xe9, 0x15, 0x93, 0x73, 0x8c, 0xc0, 0xed, 0x10, 0x2c, 0xf4,
+	0xdc, 0x21, 0xe4, 0x7d, 0x80, 0x2b, 0x31, 0xbf, 0xa2, 0x65, 0x81, 0x38, 0x8b, 0x42, 0xd7, 0xef,
+	0x58, 0x64, 0x6a, 0x01, 0x32, 0x01, 0xd2, 0xec, 0xee, 0xb7, 0x0a, 0xab, 0x3a, 0x16, 0x5d, 0x17,
+	0x8b, 0xc7, 0x77, 0x62, 0xf1, 0xa3, 0xa5, 0xf8, 0x55, 0xec, 0xd5, 0x32, 0x07, 0xb9, 0x18, 0x7c,
+	0x03, 0xfb, 0x78, 0xc7, 0xa9, 0xf7, 0xff, 0x4e, 0xbb, 0xb8, 0x6a, 0x14, 0xff, 0x15, 0xc0, 0xe0,
+	0xde, 0x47, 0x67, 0x87, 0x11, 0x9c, 0x16, 0x2c, 0xbd, 0x46, 0x53, 0xba, 0xb0, 0xb5, 0x92, 0x8e,
+	0xe0, 0x2f, 0x3c, 0xe0, 0xf2, 0xc6, 0xa9, 0x4a, 0x8d, 0xed, 0x36, 0x79, 0xe3, 0x17, 0xae, 0x26,
+	0x23, 0xd8, 0x13, 0x9c, 0x56, 0xa9, 0xdd, 0x56, 0xe3, 0xb0, 0xe1, 0x38, 0x3b, 0x82, 0xff, 0x6c,
+	0xe1, 0xc6, 0xc6, 0x33, 0xf3, 0x15, 0x66, 0xab, 0x61, 0xfe, 0xb0, 0xcc, 0x7c, 0x06, 0xa1, 0xe0,
+	0x94, 0xa7, 0x8b, 0xdc, 0xd9, 0x61, 0x0f, 0xc7, 0x67, 0x4a, 0xbf, 0x62, 0x7a, 0x26, 0xe4, 0xfc,
+	0x79, 0xc6, 0xca, 0xf2, 0x38, 0x4d, 0x55, 0x25, 0x8d, 0x90, 0x73, 0x7b, 0xd9, 0xb3, 0xd4, 0xcd,
+	0x12, 0xff, 0x11, 0x40, 0xff, 0xf4, 0x2d, 0x18, 0x32, 0x3e, 0x86, 0xbd, 0xf5, 0x58, 0x93, 0x4f,
+	0x81, 0xa8, 0x7f, 0xb1, 0x26, 0xca, 0xfe, 0xdf, 0xbf, 0xaf, 0x56, 0xd9, 0x55, 0x19, 0xff, 0x19,
+	0xc0, 0x7b, 0x0f, 0x2e, 0xa5, 0x9e, 0x88, 0xb3, 0x5c, 0x64, 0xb7, 0xb5, 0x47, 0x5b, 0xf0, 0x33,
+	0x57, 0xdb, 0x26, 0x4f, 0xa9, 0xac, 0xf2, 0x4b, 0xd4, 0x6e, 0xdc, 0x5e, 0xd2, 0xe6, 0xe9, 0xb9,
+	0xab, 0xd7, 0x56, 0xb5, 0xf1, 0x9f, 0xab, 0x6a, 0xad, 0xad, 0x2a, 0x86, 0x9e, 0xe0, 0xf4, 0xe6,
+	0xe9, 0x42, 0xbe, 0xe9, 0x08, 0xa1, 0xe0, 0xbf, 0x3c, 0x6d, 0x0c, 0x86, 0xd0, 0xf5, 0x9c, 0xda,
+	0x63, 0xcb, 0x51, 0xc0, 0x52, 0xbc, 0x4b, 0xfc, 0x7b, 0x0b, 0x0e, 0x1f, 0xce, 0x36, 0xf9, 0x10,
+	0xba, 0xfe, 0xcf, 0x50, 0x0f, 0x10, 0xf8, 0x77, 0x9c, 0xc3, 0xea, 0x19, 0x22, 0xd8, 0x6e, 0x6e,
+	0xe0, 0x9f, 0x66, 0x53, 0x92, 0x3e, 0x6c, 0x5e, 0xde, 0x1a, 0x6c, 0x06, 0xf3, 0x05, 0xf9, 0x18,
+	0xf6, 0x0d, 0x13, 0x19, 0x9d, 0x69, 0x55, 0xac, 0x3d, 0xb9, 0x5d, 0xdb, 0xf8, 0x5a, 0xab, 0xa2,
+	0xb9, 0xff, 0x47, 0xb0, 0xab, 0xd7, 0x98, 0x7e, 0xca, 0x9e, 0x5e, 0xe1, 0xc5, 0xd0, 0x6b, 0x78,
+	0xfe, 0x44, 0x3f, 0x68, 0xe8, 0x59, 0x27, 0xee, 0xdc, 0x11, 0xec, 0x69, 0x9c, 0xad, 0x9a, 0x6d,
+	0xfb, 0xc0, 0x68, 0x9c, 0x2d, 0xbb, 0x3d, 0x81, 0x9d, 0x05, 0xd3, 0xdb, 0xb5, 0x1d, 0xaf, 0x5b,
+	0xf3, 0xbc, 0xdf, 0x67, 0xd0, 0x67, 0x37, 0x73, 0x7a, 0x59, 0x71, 0x8e, 0x9a, 0xaa, 0x34, 0xad,
+	0x0a, 0x26, 0xd3, 0x5b, 0xf7, 0x92, 0x6b, 0x25, 0x84, 0xdd, 0xcc, 0x4f, 0x5c, 0xeb, 0xa2, 0xe9,
+	0x58, 0x45, 0x5a, 0xe9, 0xbb, 0x0a, 0xf0, 0x8a, 0xb4, 0xd2, 0xeb, 0x8a, 0x23, 0x18, 0x14, 0xc8,
+	0xae, 0xef, 0x4a, 0x42, 0x27, 0x79, 0x64, 0x9b, 0xf7, 0x68, 0x58, 0x96, 0xa9, 0x94, 0x19, 0x9c,
+	0x35, 0xc2, 0x52, 0xbc, 0xc1, 0xa8, 0xeb, 0x35, 0x8b, 0xa6, 0x17, 0x4e, 0xc5, 0x1b, 0x7c, 0x46,
+	0xe1, 0xe0, 0xa5, 0x2c, 0xf4, 0x7a, 0x10, 0x4e, 0x5f, 0x1b, 0x72, 0x30, 0xfe, 0xb6, 0x92, 0xa2,
+	0x40, 0x7d, 0x8e, 0xe6, 0x95, 0xd2, 0xd7, 0xe5, 0x14, 0x65, 0xa9, 0x74, 0x59, 0x7f, 0x32, 0xba,
+	0xe3, 0xa5, 0x0f, 0x74, 0xf2, 0x90, 0xcb, 0xc9, 0xe6, 0xaf, 0x1b, 0x2f, 0x8d, 0xf8, 0x67, 0x00,
+	0xcc, 0x31, 0xbe, 0x82, 0xf9, 0x07, 0x00, 0x00,
+}
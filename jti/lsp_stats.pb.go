@@ -0,0 +1,191 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: lsp_stats.proto
+
+package jti
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Top-level message
+type LspStats struct {
+	LspStatsRecords      []*LspStatsRecord `protobuf:"bytes,1,rep,name=lsp_stats_records,json=lspStatsRecords" json:"lsp_stats_records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *LspStats) Reset()         { *m = LspStats{} }
+func (m *LspStats) String() string { return proto.CompactTextString(m) }
+func (*LspStats) ProtoMessage()    {}
+func (*LspStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_lsp_stats_a100ca10b36b8ee3, []int{0}
+}
+func (m *LspStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LspStats.Unmarshal(m, b)
+}
+func (m *LspStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LspStats.Marshal(b, m, deterministic)
+}
+func (dst *LspStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LspStats.Merge(dst, src)
+}
+func (m *LspStats) XXX_Size() int {
+	return xxx_messageInfo_LspStats.Size(m)
+}
+func (m *LspStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_LspStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LspStats proto.InternalMessageInfo
+
+func (m *LspStats) GetLspStatsRecords() []*LspStatsRecord {
+	if m != nil {
+		return m.LspStatsRecords
+	}
+	return nil
+}
+
+type LspStatsRecord struct {
+	// LSP name
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Instance identifier
+	InstanceIdentifier *uint32 `protobuf:"varint,2,opt,name=instance_identifier,json=instanceIdentifier" json:"instance_identifier,omitempty"`
+	// Counter name
+	CounterName *string `protobuf:"bytes,3,opt,name=counter_name,json=counterName" json:"counter_name,omitempty"`
+	// Packets
+	Packets *uint64 `protobuf:"varint,4,opt,name=packets" json:"packets,omitempty"`
+	// Bytes
+	Bytes *uint64 `protobuf:"varint,5,opt,name=bytes" json:"bytes,omitempty"`
+	// Packet rate
+	PacketRate *uint64 `protobuf:"varint,6,opt,name=packet_rate,json=packetRate" json:"packet_rate,omitempty"`
+	// Byte rate
+	ByteRate             *uint64  `protobuf:"varint,7,opt,name=byte_rate,json=byteRate" json:"byte_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LspStatsRecord) Reset()         { *m = LspStatsRecord{} }
+func (m *LspStatsRecord) String() string { return proto.CompactTextString(m) }
+func (*LspStatsRecord) ProtoMessage()    {}
+func (*LspStatsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_lsp_stats_a100ca10b36b8ee3, []int{1}
+}
+func (m *LspStatsRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LspStatsRecord.Unmarshal(m, b)
+}
+func (m *LspStatsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LspStatsRecord.Marshal(b, m, deterministic)
+}
+func (dst *LspStatsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LspStatsRecord.Merge(dst, src)
+}
+func (m *LspStatsRecord) XXX_Size() int {
+	return xxx_messageInfo_LspStatsRecord.Size(m)
+}
+func (m *LspStatsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_LspStatsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LspStatsRecord proto.InternalMessageInfo
+
+func (m *LspStatsRecord) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *LspStatsRecord) GetInstanceIdentifier() uint32 {
+	if m != nil && m.InstanceIdentifier != nil {
+		return *m.InstanceIdentifier
+	}
+	return 0
+}
+
+func (m *LspStatsRecord) GetCounterName() string {
+	if m != nil && m.CounterName != nil {
+		return *m.CounterName
+	}
+	return ""
+}
+
+func (m *LspStatsRecord) GetPackets() uint64 {
+	if m != nil && m.Packets != nil {
+		return *m.Packets
+	}
+	return 0
+}
+
+func (m *LspStatsRecord) GetBytes() uint64 {
+	if m != nil && m.Bytes != nil {
+		return *m.Bytes
+	}
+	return 0
+}
+
+func (m *LspStatsRecord) GetPacketRate() uint64 {
+	if m != nil && m.PacketRate != nil {
+		return *m.PacketRate
+	}
+	return 0
+}
+
+func (m *LspStatsRecord) GetByteRate() uint64 {
+	if m != nil && m.ByteRate != nil {
+		return *m.ByteRate
+	}
+	return 0
+}
+
+var E_JnprLspStatisticsExt = &proto.ExtensionDesc{
+	ExtendedType:  (*JuniperNetworksSensors)(nil),
+	ExtensionType: (*LspStats)(nil),
+	Field:         5,
+	Name:          "jnpr_lsp_statistics_ext",
+	Tag:           "bytes,5,opt,name=jnpr_lsp_statistics_ext,json=jnprLspStatisticsExt",
+	Filename:      "lsp_stats.proto",
+}
+
+func init() {
+	proto.RegisterType((*LspStats)(nil), "LspStats")
+	proto.RegisterType((*LspStatsRecord)(nil), "LspStatsRecord")
+	proto.RegisterExtension(E_JnprLspStatisticsExt)
+}
+
+func init() { proto.RegisterFile("lsp_stats.proto", fileDescriptor_lsp_stats_a100ca10b36b8ee3) }
+
+var fileDescriptor_lsp_stats_a100ca10b36b8ee3 = []byte{
+	// 299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x4f, 0x6b, 0xea, 0x40,
+	0x14, 0xc5, 0xc9, 0x53, 0x9f, 0x7a, 0xf3, 0xde, 0x93, 0x37, 0x0a, 0x0e, 0xed, 0xa2, 0xa9, 0xab,
+	0xac, 0x2c, 0xb8, 0x6c, 0x77, 0x85, 0x52, 0x5a, 0x8a, 0x8b, 0xb8, 0x6a, 0x37, 0x43, 0x1a, 0x6f,
+	0x61, 0xfc, 0x33, 0x33, 0xcc, 0xbd, 0x52, 0xfd, 0xc2, 0xfd, 0x1c, 0x25, 0x33, 0xc6, 0xe2, 0x2e,
+	0xe7, 0xf7, 0x3b, 0x39, 0x03, 0x17, 0x06, 0x1b, 0x72, 0x8a, 0xb8, 0x64, 0x9a, 0x3a, 0x6f, 0xd9,
+	0x5e, 0x0c, 0x19, 0x37, 0xb8, 0x45, 0xf6, 0x07, 0xc5, 0xd6, 0x45, 0x38, 0x79, 0x84, 0xde, 0x0b,
+	0xb9, 0x45, 0x5d, 0x13, 0x77, 0xf0, 0xff, 0xf4, 0x8f, 0xf2, 0x58, 0x59, 0xbf, 0x24, 0x99, 0x64,
+	0xad, 0x3c, 0x9d, 0x0d, 0xa6, 0x4d, 0xab, 0x08, 0xbc, 0x18, 0x6c, 0xce, 0x32, 0x4d, 0xbe, 0x12,
+	0xf8, 0x77, 0xde, 0x11, 0x02, 0xda, 0xa6, 0xdc, 0xa2, 0x4c, 0xb2, 0x24, 0xef, 0x17, 0xe1, 0x5b,
+	0xdc, 0xc0, 0x50, 0x1b, 0xe2, 0xd2, 0x54, 0xa8, 0xf4, 0x12, 0x0d, 0xeb, 0x0f, 0x8d, 0x5e, 0xfe,
+	0xca, 0x92, 0xfc, 0x6f, 0x21, 0x1a, 0xf5, 0x74, 0x32, 0xe2, 0x1a, 0xfe, 0x54, 0x76, 0x67, 0x18,
+	0xbd, 0x0a, 0x63, 0xad, 0x30, 0x96, 0x1e, 0xd9, 0xbc, 0xde, 0x94, 0xd0, 0x75, 0x65, 0xb5, 0x46,
+	0x26, 0xd9, 0xce, 0x92, 0xbc, 0x5d, 0x34, 0x51, 0x8c, 0xa0, 0xf3, 0x7e, 0x60, 0x24, 0xd9, 0x09,
+	0x3c, 0x06, 0x71, 0x05, 0x69, 0x2c, 0x28, 0x5f, 0x32, 0xca, 0xdf, 0xc1, 0x41, 0x44, 0x45, 0xc9,
+	0x28, 0x2e, 0xa1, 0x5f, 0x37, 0xa3, 0xee, 0x06, 0xdd, 0xab, 0x41, 0x2d, 0x6f, 0x5f, 0x61, 0xbc,
+	0x32, 0xce, 0xab, 0xe6, 0x54, 0x9a, 0x58, 0x57, 0xa4, 0x70, 0xcf, 0x62, 0x3c, 0x7d, 0xde, 0x19,
+	0xed, 0xd0, 0xcf, 0x91, 0x3f, 0xad, 0x5f, 0xd3, 0x02, 0x0d, 0x59, 0x1f, 0x9f, 0x4f, 0x67, 0xfd,
+	0x9f, 0x23, 0x8e, 0xea, 0x89, 0x63, 0x8a, 0x03, 0x0f, 0x7b, 0xbe, 0xef, 0xbc, 0xb5, 0x56, 0xac,
+	0xbf, 0x07, 0x00, 0xa1, 0x57, 0x78, 0xbb, 0xba, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,755 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: port.proto
+
+package jti
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Top-level message
+type GPort struct {
+	InterfaceStats       []*InterfaceInfos `protobuf:"bytes,1,rep,name=interface_stats,json=interfaceStats" json:"interface_stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GPort) Reset()         { *m = GPort{} }
+func (m *GPort) String() string { return proto.CompactTextString(m) }
+func (*GPort) ProtoMessage()    {}
+func (*GPort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_port_a0efa58a16e17c5c, []int{0}
+}
+func (m *GPort) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GPort.Unmarshal(m, b)
+}
+func (m *GPort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GPort.Marshal(b, m, deterministic)
+}
+func (dst *GPort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GPort.Merge(dst, src)
+}
+func (m *GPort) XXX_Size() int {
+	return xxx_messageInfo_GPort.Size(m)
+}
+func (m *GPort) XXX_DiscardUnknown() {
+	xxx_messageInfo_GPort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GPort proto.InternalMessageInfo
+
+func (m *GPort) GetInterfaceStats() []*InterfaceInfos {
+	if m != nil {
+		return m.InterfaceStats
+	}
+	return nil
+}
+
+// Interface information
+type InterfaceInfos struct {
+	// Interface name, e.g., xe-0/0/0
+	IfName *string `protobuf:"bytes,1,opt,name=if_name,json=ifName" json:"if_name,omitempty"`
+	// Time when interface is created
+	InitTime *uint64 `protobuf:"varint,2,opt,name=init_time,json=initTime" json:"init_time,omitempty"`
+	// Global Index
+	SnmpIfIndex *uint32 `protobuf:"varint,3,opt,name=snmp_if_index,json=snmpIfIndex" json:"snmp_if_index,omitempty"`
+	// Name of parent for AE interface, if applicable
+	ParentAeName *string `protobuf:"bytes,4,opt,name=parent_ae_name,json=parentAeName" json:"parent_ae_name,omitempty"`
+	// Egress queue information
+	EgressQueueInfo []*QueueStats `protobuf:"bytes,5,rep,name=egress_queue_info,json=egressQueueInfo" json:"egress_queue_info,omitempty"`
+	// Ingress queue information
+	IngressQueueInfo []*QueueStats `protobuf:"bytes,6,rep,name=ingress_queue_info,json=ingressQueueInfo" json:"ingress_queue_info,omitempty"`
+	// Inbound traffic statistics
+	IngressStats *InterfaceStats `protobuf:"bytes,7,opt,name=ingress_stats,json=ingressStats" json:"ingress_stats,omitempty"`
+	// Outbound traffic statistics
+	EgressStats *InterfaceStats `protobuf:"bytes,8,opt,name=egress_stats,json=egressStats" json:"egress_stats,omitempty"`
+	// Inbound traffic errors
+	IngressErrors *IngressInterfaceErrors `protobuf:"bytes,9,opt,name=ingress_errors,json=ingressErrors" json:"ingress_errors,omitempty"`
+	// Interface administration status
+	IfAdministrationStatus *string `protobuf:"bytes,10,opt,name=if_administration_status,json=ifAdministrationStatus" json:"if_administration_status,omitempty"`
+	// Interface operational status
+	IfOperationalStatus *string `protobuf:"bytes,11,opt,name=if_operational_status,json=ifOperationalStatus" json:"if_operational_status,omitempty"`
+	// Interface description
+	IfDescription *string `protobuf:"bytes,12,opt,name=if_description,json=ifDescription" json:"if_description,omitempty"`
+	// Counter: number of carrier transitions on this interface
+	IfTransitions *uint64 `protobuf:"varint,13,opt,name=if_transitions,json=ifTransitions" json:"if_transitions,omitempty"`
+	// This corresponds to the ifLastChange object in the standard interface MIB
+	IfLastChange *uint32 `protobuf:"varint,14,opt,name=ifLastChange" json:"ifLastChange,omitempty"`
+	// This corresponds to the ifHighSpeed object in the standard interface MIB
+	IfHighSpeed *uint32 `protobuf:"varint,15,opt,name=ifHighSpeed" json:"ifHighSpeed,omitempty"`
+	// Outbound traffic errors
+	EgressErrors         *EgressInterfaceErrors `protobuf:"bytes,16,opt,name=egress_errors,json=egressErrors" json:"egress_errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *InterfaceInfos) Reset()         { *m = InterfaceInfos{} }
+func (m *InterfaceInfos) String() string { return proto.CompactTextString(m) }
+func (*InterfaceInfos) ProtoMessage()    {}
+func (*InterfaceInfos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_port_a0efa58a16e17c5c, []int{1}
+}
+func (m *InterfaceInfos) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InterfaceInfos.Unmarshal(m, b)
+}
+func (m *InterfaceInfos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InterfaceInfos.Marshal(b, m, deterministic)
+}
+func (dst *InterfaceInfos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterfaceInfos.Merge(dst, src)
+}
+func (m *InterfaceInfos) XXX_Size() int {
+	return xxx_messageInfo_InterfaceInfos.Size(m)
+}
+func (m *InterfaceInfos) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterfaceInfos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterfaceInfos proto.InternalMessageInfo
+
+func (m *InterfaceInfos) GetIfName() string {
+	if m != nil && m.IfName != nil {
+		return *m.IfName
+	}
+	return ""
+}
+
+func (m *InterfaceInfos) GetInitTime() uint64 {
+	if m != nil && m.InitTime != nil {
+		return *m.InitTime
+	}
+	return 0
+}
+
+func (m *InterfaceInfos) GetSnmpIfIndex() uint32 {
+	if m != nil && m.SnmpIfIndex != nil {
+		return *m.SnmpIfIndex
+	}
+	return 0
+}
+
+func (m *InterfaceInfos) GetParentAeName() string {
+	if m != nil && m.ParentAeName != nil {
+		return *m.ParentAeName
+	}
+	return ""
+}
+
+func (m *InterfaceInfos) GetEgressQueueInfo() []*QueueStats {
+	if m != nil {
+		return m.EgressQueueInfo
+	}
+	return nil
+}
+
+func (m *InterfaceInfos) GetIngressQueueInfo() []*QueueStats {
+	if m != nil {
+		return m.IngressQueueInfo
+	}
+	return nil
+}
+
+func (m *InterfaceInfos) GetIngressStats() *InterfaceStats {
+	if m != nil {
+		return m.IngressStats
+	}
+	return nil
+}
+
+func (m *InterfaceInfos) GetEgressStats() *InterfaceStats {
+	if m != nil {
+		return m.EgressStats
+	}
+	return nil
+}
+
+func (m *InterfaceInfos) GetIngressErrors() *IngressInterfaceErrors {
+	if m != nil {
+		return m.IngressErrors
+	}
+	return nil
+}
+
+func (m *InterfaceInfos) GetIfAdministrationStatus() string {
+	if m != nil && m.IfAdministrationStatus != nil {
+		return *m.IfAdministrationStatus
+	}
+	return ""
+}
+
+func (m *InterfaceInfos) GetIfOperationalStatus() string {
+	if m != nil && m.IfOperationalStatus != nil {
+		return *m.IfOperationalStatus
+	}
+	return ""
+}
+
+func (m *InterfaceInfos) GetIfDescription() string {
+	if m != nil && m.IfDescription != nil {
+		return *m.IfDescription
+	}
+	return ""
+}
+
+func (m *InterfaceInfos) GetIfTransitions() uint64 {
+	if m != nil && m.IfTransitions != nil {
+		return *m.IfTransitions
+	}
+	return 0
+}
+
+func (m *InterfaceInfos) GetIfLastChange() uint32 {
+	if m != nil && m.IfLastChange != nil {
+		return *m.IfLastChange
+	}
+	return 0
+}
+
+func (m *InterfaceInfos) GetIfHighSpeed() uint32 {
+	if m != nil && m.IfHighSpeed != nil {
+		return *m.IfHighSpeed
+	}
+	return 0
+}
+
+func (m *InterfaceInfos) GetEgressErrors() *EgressInterfaceErrors {
+	if m != nil {
+		return m.EgressErrors
+	}
+	return nil
+}
+
+// Interface queue statistics
+type QueueStats struct {
+	// Queue number
+	QueueNumber *uint32 `protobuf:"varint,1,opt,name=queue_number,json=queueNumber" json:"queue_number,omitempty"`
+	// The total number of packets that have been added to this queue
+	Packets *uint64 `protobuf:"varint,2,opt,name=packets" json:"packets,omitempty"`
+	// The total number of bytes that have been added to this queue
+	Bytes *uint64 `protobuf:"varint,3,opt,name=bytes" json:"bytes,omitempty"`
+	// The total number of tail dropped packets
+	TailDropPackets *uint64 `protobuf:"varint,4,opt,name=tail_drop_packets,json=tailDropPackets" json:"tail_drop_packets,omitempty"`
+	// The total number of rate-limited packets
+	RlDropPackets *uint64 `protobuf:"varint,5,opt,name=rl_drop_packets,json=rlDropPackets" json:"rl_drop_packets,omitempty"`
+	// The total number of rate-limited bytes
+	RlDropBytes *uint64 `protobuf:"varint,6,opt,name=rl_drop_bytes,json=rlDropBytes" json:"rl_drop_bytes,omitempty"`
+	// The total number of red-dropped packets
+	RedDropPackets *uint64 `protobuf:"varint,7,opt,name=red_drop_packets,json=redDropPackets" json:"red_drop_packets,omitempty"`
+	// The total number of red-dropped bytes
+	RedDropBytes *uint64 `protobuf:"varint,8,opt,name=red_drop_bytes,json=redDropBytes" json:"red_drop_bytes,omitempty"`
+	// Average queue depth, in packets
+	AvgBufferOccupancy *uint64 `protobuf:"varint,9,opt,name=avg_buffer_occupancy,json=avgBufferOccupancy" json:"avg_buffer_occupancy,omitempty"`
+	// Current queue depth, in packets
+	CurBufferOccupancy *uint64 `protobuf:"varint,10,opt,name=cur_buffer_occupancy,json=curBufferOccupancy" json:"cur_buffer_occupancy,omitempty"`
+	// The max measured queue depth, in packets, across all measurements since boot
+	PeakBufferOccupancy *uint64 `protobuf:"varint,11,opt,name=peak_buffer_occupancy,json=peakBufferOccupancy" json:"peak_buffer_occupancy,omitempty"`
+	// Allocated buffer size
+	AllocatedBufferSize  *uint64  `protobuf:"varint,12,opt,name=allocated_buffer_size,json=allocatedBufferSize" json:"allocated_buffer_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueueStats) Reset()         { *m = QueueStats{} }
+func (m *QueueStats) String() string { return proto.CompactTextString(m) }
+func (*QueueStats) ProtoMessage()    {}
+func (*QueueStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_port_a0efa58a16e17c5c, []int{2}
+}
+func (m *QueueStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueueStats.Unmarshal(m, b)
+}
+func (m *QueueStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueueStats.Marshal(b, m, deterministic)
+}
+func (dst *QueueStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueStats.Merge(dst, src)
+}
+func (m *QueueStats) XXX_Size() int {
+	return xxx_messageInfo_QueueStats.Size(m)
+}
+func (m *QueueStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueStats proto.InternalMessageInfo
+
+func (m *QueueStats) GetQueueNumber() uint32 {
+	if m != nil && m.QueueNumber != nil {
+		return *m.QueueNumber
+	}
+	return 0
+}
+
+func (m *QueueStats) GetPackets() uint64 {
+	if m != nil && m.Packets != nil {
+		return *m.Packets
+	}
+	return 0
+}
+
+func (m *QueueStats) GetBytes() uint64 {
+	if m != nil && m.Bytes != nil {
+		return *m.Bytes
+	}
+	return 0
+}
+
+func (m *QueueStats) GetTailDropPackets() uint64 {
+	if m != nil && m.TailDropPackets != nil {
+		return *m.TailDropPackets
+	}
+	return 0
+}
+
+func (m *QueueStats) GetRlDropPackets() uint64 {
+	if m != nil && m.RlDropPackets != nil {
+		return *m.RlDropPackets
+	}
+	return 0
+}
+
+func (m *QueueStats) GetRlDropBytes() uint64 {
+	if m != nil && m.RlDropBytes != nil {
+		return *m.RlDropBytes
+	}
+	return 0
+}
+
+func (m *QueueStats) GetRedDropPackets() uint64 {
+	if m != nil && m.RedDropPackets != nil {
+		return *m.RedDropPackets
+	}
+	return 0
+}
+
+func (m *QueueStats) GetRedDropBytes() uint64 {
+	if m != nil && m.RedDropBytes != nil {
+		return *m.RedDropBytes
+	}
+	return 0
+}
+
+func (m *QueueStats) GetAvgBufferOccupancy() uint64 {
+	if m != nil && m.AvgBufferOccupancy != nil {
+		return *m.AvgBufferOccupancy
+	}
+	return 0
+}
+
+func (m *QueueStats) GetCurBufferOccupancy() uint64 {
+	if m != nil && m.CurBufferOccupancy != nil {
+		return *m.CurBufferOccupancy
+	}
+	return 0
+}
+
+func (m *QueueStats) GetPeakBufferOccupancy() uint64 {
+	if m != nil && m.PeakBufferOccupancy != nil {
+		return *m.PeakBufferOccupancy
+	}
+	return 0
+}
+
+func (m *QueueStats) GetAllocatedBufferSize() uint64 {
+	if m != nil && m.AllocatedBufferSize != nil {
+		return *m.AllocatedBufferSize
+	}
+	return 0
+}
+
+// Interface statistics
+type InterfaceStats struct {
+	// The total number of packets sent/received by this interface
+	IfPkts *uint64 `protobuf:"varint,1,opt,name=if_pkts,json=ifPkts" json:"if_pkts,omitempty"`
+	// The total number of bytes sent/received by this interface
+	IfOctets *uint64 `protobuf:"varint,2,opt,name=if_octets,json=ifOctets" json:"if_octets,omitempty"`
+	// The rate at which packets are sent/received by this interface (in packets/sec)
+	If_1SecPkts *uint64 `protobuf:"varint,3,opt,name=if_1sec_pkts,json=if1secPkts" json:"if_1sec_pkts,omitempty"`
+	// The rate at which bytes are sent/received by this interface
+	If_1SecOctets *uint64 `protobuf:"varint,4,opt,name=if_1sec_octets,json=if1secOctets" json:"if_1sec_octets,omitempty"`
+	// Total number of unicast packets sent/received by this interface
+	IfUcPkts *uint64 `protobuf:"varint,5,opt,name=if_uc_pkts,json=ifUcPkts" json:"if_uc_pkts,omitempty"`
+	// Total number of multicast packets sent/received by this interface
+	IfMcPkts *uint64 `protobuf:"varint,6,opt,name=if_mc_pkts,json=ifMcPkts" json:"if_mc_pkts,omitempty"`
+	// Total number of broadcast packets sent/received by this interface
+	IfBcPkts *uint64 `protobuf:"varint,7,opt,name=if_bc_pkts,json=ifBcPkts" json:"if_bc_pkts,omitempty"`
+	// Counter: total number of error packets sent/rcvd by this interface
+	IfError *uint64 `protobuf:"varint,8,opt,name=if_error,json=ifError" json:"if_error,omitempty"`
+	// Counter: total number of PAUSE packets sent/rcvd by this interface
+	IfPausePkts *uint64 `protobuf:"varint,9,opt,name=if_pause_pkts,json=ifPausePkts" json:"if_pause_pkts,omitempty"`
+	// Counter: total number of unknown proto packets sent/rcvd by this interface
+	IfUnknownProtoPkts   *uint64  `protobuf:"varint,10,opt,name=if_unknown_proto_pkts,json=ifUnknownProtoPkts" json:"if_unknown_proto_pkts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InterfaceStats) Reset()         { *m = InterfaceStats{} }
+func (m *InterfaceStats) String() string { return proto.CompactTextString(m) }
+func (*InterfaceStats) ProtoMessage()    {}
+func (*InterfaceStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_port_a0efa58a16e17c5c, []int{3}
+}
+func (m *InterfaceStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InterfaceStats.Unmarshal(m, b)
+}
+func (m *InterfaceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InterfaceStats.Marshal(b, m, deterministic)
+}
+func (dst *InterfaceStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterfaceStats.Merge(dst, src)
+}
+func (m *InterfaceStats) XXX_Size() int {
+	return xxx_messageInfo_InterfaceStats.Size(m)
+}
+func (m *InterfaceStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterfaceStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterfaceStats proto.InternalMessageInfo
+
+func (m *InterfaceStats) GetIfPkts() uint64 {
+	if m != nil && m.IfPkts != nil {
+		return *m.IfPkts
+	}
+	return 0
+}
+
+func (m *InterfaceStats) GetIfOctets() uint64 {
+	if m != nil && m.IfOctets != nil {
+		return *m.IfOctets
+	}
+	return 0
+}
+
+func (m *InterfaceStats) GetIf_1SecPkts() uint64 {
+	if m != nil && m.If_1SecPkts != nil {
+		return *m.If_1SecPkts
+	}
+	return 0
+}
+
+func (m *InterfaceStats) GetIf_1SecOctets() uint64 {
+	if m != nil && m.If_1SecOctets != nil {
+		return *m.If_1SecOctets
+	}
+	return 0
+}
+
+func (m *InterfaceStats) GetIfUcPkts() uint64 {
+	if m != nil && m.IfUcPkts != nil {
+		return *m.IfUcPkts
+	}
+	return 0
+}
+
+func (m *InterfaceStats) GetIfMcPkts() uint64 {
+	if m != nil && m.IfMcPkts != nil {
+		return *m.IfMcPkts
+	}
+	return 0
+}
+
+func (m *InterfaceStats) GetIfBcPkts() uint64 {
+	if m != nil && m.IfBcPkts != nil {
+		return *m.IfBcPkts
+	}
+	return 0
+}
+
+func (m *InterfaceStats) GetIfError() uint64 {
+	if m != nil && m.IfError != nil {
+		return *m.IfError
+	}
+	return 0
+}
+
+func (m *InterfaceStats) GetIfPausePkts() uint64 {
+	if m != nil && m.IfPausePkts != nil {
+		return *m.IfPausePkts
+	}
+	return 0
+}
+
+func (m *InterfaceStats) GetIfUnknownProtoPkts() uint64 {
+	if m != nil && m.IfUnknownProtoPkts != nil {
+		return *m.IfUnknownProtoPkts
+	}
+	return 0
+}
+
+// Inbound traffic error statistics
+type IngressInterfaceErrors struct {
+	// The number of packets that contained errors
+	IfErrors *uint64 `protobuf:"varint,1,opt,name=if_errors,json=ifErrors" json:"if_errors,omitempty"`
+	// The number of packets dropped by the input queue of the I/O Manager ASIC
+	IfInQdrops *uint64 `protobuf:"varint,2,opt,name=if_in_qdrops,json=ifInQdrops" json:"if_in_qdrops,omitempty"`
+	// The number of packets which were misaligned
+	IfInFrameErrors *uint64 `protobuf:"varint,3,opt,name=if_in_frame_errors,json=ifInFrameErrors" json:"if_in_frame_errors,omitempty"`
+	// The number of non-error packets which were chosen to be discarded
+	IfDiscards *uint64 `protobuf:"varint,4,opt,name=if_discards,json=ifDiscards" json:"if_discards,omitempty"`
+	// The number of runt packets
+	IfInRunts *uint64 `protobuf:"varint,5,opt,name=if_in_runts,json=ifInRunts" json:"if_in_runts,omitempty"`
+	// The number of packets that fail Layer 3 sanity checks of the header
+	IfInL3Incompletes *uint64 `protobuf:"varint,6,opt,name=if_in_l3_incompletes,json=ifInL3Incompletes" json:"if_in_l3_incompletes,omitempty"`
+	// The number of packets for which the software could not find a valid logical interface
+	IfInL2ChanErrors *uint64 `protobuf:"varint,7,opt,name=if_in_l2chan_errors,json=ifInL2chanErrors" json:"if_in_l2chan_errors,omitempty"`
+	// The number of malform or short packets
+	IfInL2MismatchTimeouts *uint64 `protobuf:"varint,8,opt,name=if_in_l2_mismatch_timeouts,json=ifInL2MismatchTimeouts" json:"if_in_l2_mismatch_timeouts,omitempty"`
+	// The number of FIFO errors
+	IfInFifoErrors *uint64 `protobuf:"varint,9,opt,name=if_in_fifo_errors,json=ifInFifoErrors" json:"if_in_fifo_errors,omitempty"`
+	// The number of resource errors
+	IfInResourceErrors   *uint64  `protobuf:"varint,10,opt,name=if_in_resource_errors,json=ifInResourceErrors" json:"if_in_resource_errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IngressInterfaceErrors) Reset()         { *m = IngressInterfaceErrors{} }
+func (m *IngressInterfaceErrors) String() string { return proto.CompactTextString(m) }
+func (*IngressInterfaceErrors) ProtoMessage()    {}
+func (*IngressInterfaceErrors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_port_a0efa58a16e17c5c, []int{4}
+}
+func (m *IngressInterfaceErrors) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IngressInterfaceErrors.Unmarshal(m, b)
+}
+func (m *IngressInterfaceErrors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IngressInterfaceErrors.Marshal(b, m, deterministic)
+}
+func (dst *IngressInterfaceErrors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IngressInterfaceErrors.Merge(dst, src)
+}
+func (m *IngressInterfaceErrors) XXX_Size() int {
+	return xxx_messageInfo_IngressInterfaceErrors.Size(m)
+}
+func (m *IngressInterfaceErrors) XXX_DiscardUnknown() {
+	xxx_messageInfo_IngressInterfaceErrors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IngressInterfaceErrors proto.InternalMessageInfo
+
+func (m *IngressInterfaceErrors) GetIfErrors() uint64 {
+	if m != nil && m.IfErrors != nil {
+		return *m.IfErrors
+	}
+	return 0
+}
+
+func (m *IngressInterfaceErrors) GetIfInQdrops() uint64 {
+	if m != nil && m.IfInQdrops != nil {
+		return *m.IfInQdrops
+	}
+	return 0
+}
+
+func (m *IngressInterfaceErrors) GetIfInFrameErrors() uint64 {
+	if m != nil && m.IfInFrameErrors != nil {
+		return *m.IfInFrameErrors
+	}
+	return 0
+}
+
+func (m *IngressInterfaceErrors) GetIfDiscards() uint64 {
+	if m != nil && m.IfDiscards != nil {
+		return *m.IfDiscards
+	}
+	return 0
+}
+
+func (m *IngressInterfaceErrors) GetIfInRunts() uint64 {
+	if m != nil && m.IfInRunts != nil {
+		return *m.IfInRunts
+	}
+	return 0
+}
+
+func (m *IngressInterfaceErrors) GetIfInL3Incompletes() uint64 {
+	if m != nil && m.IfInL3Incompletes != nil {
+		return *m.IfInL3Incompletes
+	}
+	return 0
+}
+
+func (m *IngressInterfaceErrors) GetIfInL2ChanErrors() uint64 {
+	if m != nil && m.IfInL2ChanErrors != nil {
+		return *m.IfInL2ChanErrors
+	}
+	return 0
+}
+
+func (m *IngressInterfaceErrors) GetIfInL2MismatchTimeouts() uint64 {
+	if m != nil && m.IfInL2MismatchTimeouts != nil {
+		return *m.IfInL2MismatchTimeouts
+	}
+	return 0
+}
+
+func (m *IngressInterfaceErrors) GetIfInFifoErrors() uint64 {
+	if m != nil && m.IfInFifoErrors != nil {
+		return *m.IfInFifoErrors
+	}
+	return 0
+}
+
+func (m *IngressInterfaceErrors) GetIfInResourceErrors() uint64 {
+	if m != nil && m.IfInResourceErrors != nil {
+		return *m.IfInResourceErrors
+	}
+	return 0
+}
+
+// Outbound traffic error statistics
+type EgressInterfaceErrors struct {
+	// The number of packets that contained errors
+	IfErrors *uint64 `protobuf:"varint,1,opt,name=if_errors,json=ifErrors" json:"if_errors,omitempty"`
+	// The number of non-error packets which were chosen to be discarded
+	IfDiscards           *uint64  `protobuf:"varint,2,opt,name=if_discards,json=ifDiscards" json:"if_discards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EgressInterfaceErrors) Reset()         { *m = EgressInterfaceErrors{} }
+func (m *EgressInterfaceErrors) String() string { return proto.CompactTextString(m) }
+func (*EgressInterfaceErrors) ProtoMessage()    {}
+func (*EgressInterfaceErrors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_port_a0efa58a16e17c5c, []int{5}
+}
+func (m *EgressInterfaceErrors) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EgressInterfaceErrors.Unmarshal(m, b)
+}
+func (m *EgressInterfaceErrors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EgressInterfaceErrors.Marshal(b, m, deterministic)
+}
+func (dst *EgressInterfaceErrors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressInterfaceErrors.Merge(dst, src)
+}
+func (m *EgressInterfaceErrors) XXX_Size() int {
+	return xxx_messageInfo_EgressInterfaceErrors.Size(m)
+}
+func (m *EgressInterfaceErrors) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressInterfaceErrors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EgressInterfaceErrors proto.InternalMessageInfo
+
+func (m *EgressInterfaceErrors) GetIfErrors() uint64 {
+	if m != nil && m.IfErrors != nil {
+		return *m.IfErrors
+	}
+	return 0
+}
+
+func (m *EgressInterfaceErrors) GetIfDiscards() uint64 {
+	if m != nil && m.IfDiscards != nil {
+		return *m.IfDiscards
+	}
+	return 0
+}
+
+var E_JnprInterfaceExt = &proto.ExtensionDesc{
+	ExtendedType:  (*JuniperNetworksSensors)(nil),
+	ExtensionType: (*GPort)(nil),
+	Field:         3,
+	Name:          "jnpr_interface_ext",
+	Tag:           "bytes,3,opt,name=jnpr_interface_ext,json=jnprInterfaceExt",
+	Filename:      "port.proto",
+}
+
+func init() {
+	proto.RegisterType((*GPort)(nil), "GPort")
+	proto.RegisterType((*InterfaceInfos)(nil), "InterfaceInfos")
+	proto.RegisterType((*QueueStats)(nil), "QueueStats")
+	proto.RegisterType((*InterfaceStats)(nil), "InterfaceStats")
+	proto.RegisterType((*IngressInterfaceErrors)(nil), "IngressInterfaceErrors")
+	proto.RegisterType((*EgressInterfaceErrors)(nil), "EgressInterfaceErrors")
+	proto.RegisterExtension(E_JnprInterfaceExt)
+}
+
+func init() { proto.RegisterFile("port.proto", fileDescriptor_port_a0efa58a16e17c5c) }
+
+var fileDescriptor_port_a0efa58a16e17c5c = []byte{
+	// 1061 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x5b, 0x73, 0x1b, 0xb5,
+	0x17, 0x1f, 0x37, 0xbe, 0x24, 0xc7, 0xb7, 0x44, 0x69, 0x93, 0xfd, 0xf7, 0xcf, 0x80, 0xf1, 0x04,
+	0x26, 0xc0, 0x60, 0xa8, 0xcb, 0x0c, 0xa5, 0xcc, 0x30, 0x93, 0x90, 0x00, 0x66, 0x9a, 0x4b, 0x37,
+	0xc9, 0x0b, 0x2f, 0x1a, 0x65, 0x2d, 0x25, 0xa7, 0xf6, 0x6a, 0xb7, 0x92, 0xb6, 0x4d, 0xfa, 0xe5,
+	0xf8, 0x1e, 0x7c, 0x07, 0x9e, 0xf8, 0x02, 0x8c, 0x2e, 0xeb, 0x4b, 0x92, 0x17, 0x1e, 0xf5, 0xbb,
+	0x1c, 0xc9, 0xbf, 0x73, 0xa4, 0x35, 0x40, 0x9e, 0x29, 0x33, 0xc8, 0x55, 0x66, 0xb2, 0xa7, 0x9b,
+	0x86, 0x4f, 0x79, 0xca, 0x8d, 0xba, 0xa5, 0x26, 0xcb, 0x3d, 0xd8, 0xdf, 0x83, 0xda, 0xaf, 0xa7,
+	0x99, 0x32, 0xe4, 0x05, 0x74, 0x51, 0x1a, 0xae, 0x04, 0x4b, 0x38, 0xd5, 0x86, 0x19, 0x1d, 0x55,
+	0x7a, 0x2b, 0xbb, 0xcd, 0x61, 0x77, 0x30, 0x2a, 0xf1, 0x91, 0x14, 0x99, 0x8e, 0x3b, 0x33, 0xdd,
+	0x99, 0x95, 0xf5, 0xff, 0xae, 0x41, 0x67, 0x59, 0x42, 0xb6, 0xa1, 0x81, 0x82, 0x4a, 0x96, 0xf2,
+	0xa8, 0xd2, 0xab, 0xec, 0xae, 0xc5, 0x75, 0x14, 0xc7, 0x2c, 0xe5, 0xe4, 0xff, 0xb0, 0x86, 0x12,
+	0x0d, 0x35, 0x98, 0xf2, 0xe8, 0x51, 0xaf, 0xb2, 0x5b, 0x8d, 0x57, 0x2d, 0x70, 0x8e, 0x29, 0x27,
+	0x7d, 0x68, 0x6b, 0x99, 0xe6, 0x14, 0x05, 0x45, 0x39, 0xe6, 0x37, 0xd1, 0x4a, 0xaf, 0xb2, 0xdb,
+	0x8e, 0x9b, 0x16, 0x1c, 0x89, 0x91, 0x85, 0xc8, 0x0e, 0x74, 0x72, 0xa6, 0xb8, 0x34, 0x94, 0x71,
+	0xbf, 0x41, 0xd5, 0x6d, 0xd0, 0xf2, 0xe8, 0x1e, 0x77, 0xdb, 0x7c, 0x0f, 0x1b, 0xfc, 0x4a, 0x71,
+	0xad, 0xe9, 0xdb, 0x82, 0x17, 0x9c, 0xa2, 0x14, 0x59, 0x54, 0x73, 0x3f, 0xa7, 0x39, 0x78, 0x6d,
+	0x21, 0x77, 0xf4, 0xb8, 0xeb, 0x55, 0x0e, 0xb1, 0x27, 0x27, 0x3f, 0x00, 0x41, 0x79, 0xcf, 0x59,
+	0xbf, 0xef, 0x5c, 0x0f, 0xb2, 0xb9, 0xf5, 0x3b, 0x68, 0x97, 0x56, 0x1f, 0x5f, 0xa3, 0x57, 0x59,
+	0x8e, 0xcf, 0x3b, 0x5b, 0x41, 0xe5, 0x56, 0x64, 0x08, 0x2d, 0xbe, 0x68, 0x5a, 0x7d, 0xd8, 0xd4,
+	0xe4, 0x0b, 0x9e, 0x9f, 0xa0, 0x53, 0xee, 0xc4, 0x95, 0xca, 0x94, 0x8e, 0xd6, 0x9c, 0x6b, 0x7b,
+	0x30, 0xf2, 0xf0, 0xcc, 0x7c, 0xe8, 0xe8, 0xb8, 0x3c, 0x98, 0x5f, 0x92, 0x17, 0x10, 0xa1, 0xa0,
+	0x6c, 0x9c, 0xa2, 0x44, 0x6d, 0x14, 0x33, 0x98, 0x49, 0xb7, 0x7d, 0xa1, 0x23, 0x70, 0x69, 0x6e,
+	0xa1, 0xd8, 0x5b, 0xa2, 0xcf, 0x1c, 0x4b, 0x86, 0xf0, 0x04, 0x05, 0xcd, 0x72, 0xee, 0x51, 0x36,
+	0x2d, 0x6d, 0x4d, 0x67, 0xdb, 0x44, 0x71, 0x32, 0xe7, 0x82, 0xe7, 0x33, 0xe8, 0xa0, 0xa0, 0x63,
+	0xae, 0x13, 0x85, 0xb9, 0x65, 0xa2, 0x96, 0x13, 0xb7, 0x51, 0x1c, 0xcc, 0xc1, 0x20, 0x33, 0x8a,
+	0x49, 0x8d, 0x16, 0xd0, 0x51, 0xdb, 0x8d, 0x47, 0x1b, 0xc5, 0xf9, 0x1c, 0x24, 0x7d, 0x68, 0xa1,
+	0x78, 0xc5, 0xb4, 0xf9, 0xf9, 0x9a, 0xc9, 0x2b, 0x1e, 0x75, 0xdc, 0x88, 0x2c, 0x61, 0xa4, 0x07,
+	0x4d, 0x14, 0xbf, 0xe1, 0xd5, 0xf5, 0x59, 0xce, 0xf9, 0x38, 0xea, 0xfa, 0x29, 0x5a, 0x80, 0xc8,
+	0x8f, 0xd0, 0xe6, 0x4b, 0x01, 0xae, 0xbb, 0x00, 0xb7, 0x06, 0x87, 0x0f, 0xe6, 0x17, 0x5a, 0xe4,
+	0x57, 0xfd, 0x7f, 0x56, 0x00, 0xe6, 0x93, 0x40, 0x3e, 0x85, 0x96, 0x1f, 0x15, 0x59, 0xa4, 0x97,
+	0x5c, 0xb9, 0x81, 0x6f, 0xc7, 0x4d, 0x87, 0x1d, 0x3b, 0x88, 0x44, 0xd0, 0xc8, 0x59, 0x32, 0xe1,
+	0x46, 0x87, 0x99, 0x2f, 0x97, 0xe4, 0x31, 0xd4, 0x2e, 0x6f, 0x0d, 0xd7, 0x6e, 0xd4, 0xab, 0xb1,
+	0x5f, 0x90, 0x2f, 0x61, 0xc3, 0x30, 0x9c, 0xd2, 0xb1, 0xca, 0x72, 0x5a, 0x3a, 0xab, 0x4e, 0xd1,
+	0xb5, 0xc4, 0x81, 0xca, 0xf2, 0xd3, 0x50, 0xe1, 0x73, 0xe8, 0xaa, 0x3b, 0xca, 0x9a, 0x0f, 0x4e,
+	0x2d, 0xe9, 0xfa, 0xd0, 0x2e, 0x75, 0x7e, 0xc7, 0xba, 0x53, 0x35, 0xbd, 0x6a, 0xdf, 0xed, 0xbb,
+	0x0b, 0xeb, 0x8a, 0x8f, 0x97, 0x8b, 0x35, 0x9c, 0xac, 0xa3, 0xf8, 0x78, 0xb1, 0xda, 0x0e, 0x74,
+	0x66, 0x4a, 0x5f, 0x6e, 0xd5, 0xe9, 0x5a, 0x41, 0xe7, 0xeb, 0x7d, 0x0b, 0x8f, 0xd9, 0xbb, 0x2b,
+	0x7a, 0x59, 0x08, 0xc1, 0x15, 0xcd, 0x92, 0xa4, 0xc8, 0x99, 0x4c, 0x6e, 0xdd, 0xb8, 0x56, 0x63,
+	0xc2, 0xde, 0x5d, 0xed, 0x3b, 0xea, 0xa4, 0x64, 0xac, 0x23, 0x29, 0xd4, 0x7d, 0x07, 0x78, 0x47,
+	0x52, 0xa8, 0xbb, 0x8e, 0x21, 0x3c, 0xc9, 0x39, 0x9b, 0xdc, 0xb7, 0x34, 0x9d, 0x65, 0xd3, 0x92,
+	0x0f, 0x78, 0xd8, 0x74, 0x9a, 0x25, 0xcc, 0xf0, 0x71, 0x69, 0xd4, 0xf8, 0x81, 0xbb, 0xc9, 0xac,
+	0xc6, 0x9b, 0x33, 0xd2, 0x1b, 0xcf, 0xf0, 0x03, 0xef, 0xff, 0xf5, 0x68, 0xe1, 0x95, 0xf3, 0x9d,
+	0xf7, 0xaf, 0x5c, 0x3e, 0x71, 0x4f, 0xa5, 0x35, 0xd6, 0x51, 0x9c, 0x4e, 0x8c, 0x76, 0xaf, 0x9c,
+	0xa0, 0x59, 0x62, 0xe6, 0x1d, 0x5f, 0x45, 0x71, 0xe2, 0xd6, 0xa4, 0x67, 0x27, 0x98, 0x3e, 0xd3,
+	0x3c, 0xf1, 0x56, 0xdf, 0x79, 0x40, 0x61, 0x21, 0x67, 0xdf, 0x71, 0x57, 0xc1, 0x29, 0x42, 0x0d,
+	0xdf, 0xfb, 0x96, 0xd7, 0x84, 0x3a, 0x1f, 0x01, 0xa0, 0xa0, 0x45, 0xa8, 0x52, 0x2b, 0x77, 0xb9,
+	0xf0, 0x35, 0x3c, 0x9b, 0x06, 0xb6, 0x5e, 0xb2, 0x47, 0x8b, 0xec, 0x65, 0x60, 0x1b, 0x25, 0xbb,
+	0xef, 0xd9, 0xff, 0xc1, 0x2a, 0x0a, 0x7f, 0x33, 0x42, 0x5b, 0x1b, 0x28, 0xdc, 0xf0, 0xdb, 0x29,
+	0xb2, 0x3f, 0x99, 0x15, 0x9a, 0x7b, 0xaf, 0x6f, 0x65, 0x13, 0xc5, 0xa9, 0xc5, 0x9c, 0xfd, 0x99,
+	0x7b, 0x24, 0x0a, 0x39, 0x91, 0xd9, 0x7b, 0x49, 0xdd, 0x67, 0xc6, 0x6b, 0x43, 0x13, 0x51, 0x5c,
+	0x78, 0xee, 0xd4, 0x52, 0xd6, 0xd2, 0xff, 0x73, 0x05, 0xb6, 0x1e, 0x7e, 0xbb, 0x42, 0x96, 0xe1,
+	0x9a, 0x56, 0xca, 0x93, 0x06, 0xd2, 0x67, 0x89, 0x92, 0xbe, 0xb5, 0x93, 0x58, 0x66, 0x0d, 0x28,
+	0x46, 0xf2, 0xb5, 0x43, 0xc8, 0x57, 0x40, 0xbc, 0x42, 0x28, 0x96, 0xf2, 0xb2, 0x8e, 0xcf, 0xbc,
+	0x6b, 0x75, 0xbf, 0x58, 0x3c, 0x94, 0xfb, 0xc4, 0x3e, 0x1c, 0x74, 0x8c, 0x3a, 0x61, 0x6a, 0x5c,
+	0xa6, 0x0e, 0x28, 0x0e, 0x02, 0x42, 0x3e, 0x76, 0x02, 0x94, 0x54, 0x15, 0x72, 0x16, 0xfa, 0x9a,
+	0x2d, 0x13, 0x5b, 0x80, 0x7c, 0x03, 0x8f, 0x3d, 0x3f, 0x7d, 0x4e, 0x51, 0x26, 0x59, 0x9a, 0x4f,
+	0xf9, 0xfc, 0xae, 0x6d, 0x58, 0xe1, 0xab, 0xe7, 0xa3, 0x39, 0x41, 0xbe, 0x86, 0xcd, 0x60, 0x18,
+	0x26, 0xd7, 0x4c, 0x96, 0xe7, 0xf3, 0x1d, 0x59, 0x77, 0x7a, 0x47, 0x84, 0x03, 0xbe, 0x84, 0xa7,
+	0xa5, 0x9c, 0xa6, 0xa8, 0x53, 0x66, 0x92, 0x6b, 0xf7, 0x2d, 0xcd, 0x0a, 0x53, 0x5e, 0xc1, 0x2d,
+	0xef, 0x3a, 0x0a, 0xf4, 0x79, 0x60, 0xc9, 0x17, 0xb0, 0x11, 0x92, 0x40, 0x91, 0x2d, 0x7e, 0x38,
+	0xaa, 0x71, 0xc7, 0x05, 0x81, 0x22, 0x0b, 0xdb, 0xf8, 0x0e, 0xda, 0x9f, 0xc9, 0x75, 0x56, 0xa8,
+	0x64, 0x96, 0xdb, 0xac, 0x83, 0x23, 0x19, 0x07, 0x2a, 0x3c, 0x8a, 0x17, 0xf0, 0xe4, 0xf0, 0xbf,
+	0xf7, 0xef, 0x4e, 0xe0, 0x8f, 0xee, 0x06, 0xfe, 0xf2, 0x08, 0xc8, 0x1b, 0x99, 0x2b, 0x3a, 0xff,
+	0x6b, 0xc2, 0x6f, 0x0c, 0xd9, 0x1e, 0xfc, 0x5e, 0x48, 0xcc, 0xb9, 0x3a, 0xe6, 0xe6, 0x7d, 0xa6,
+	0x26, 0xfa, 0x8c, 0x4b, 0x5d, 0xf6, 0xb5, 0x39, 0xac, 0x0f, 0xdc, 0x5f, 0x9a, 0x78, 0xdd, 0x5a,
+	0xe7, 0xe7, 0xb9, 0x31, 0xfb, 0xb5, 0x3f, 0x56, 0xde, 0x18, 0xfc, 0x77, 0x00, 0x48, 0x90, 0x75,
+	0x09, 0x16, 0x09, 0x00, 0x00,
+}
@@ -0,0 +1,314 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: telemetry_top.proto
+
+package jti
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Top-level message
+type TelemetryStream struct {
+	// router hostname
+	// (or, just in the case of legacy (microkernel) PFEs, the IP address)
+	SystemId *string `protobuf:"bytes,1,req,name=system_id,json=systemId" json:"system_id,omitempty"`
+	// line card / RE (slot number). For RE, it will be 65535
+	ComponentId *uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId" json:"component_id,omitempty"`
+	// PFE (if applicable)
+	SubComponentId *uint32 `protobuf:"varint,3,opt,name=sub_component_id,json=subComponentId" json:"sub_component_id,omitempty"`
+	// Overload sensor name with "sensor name, internal path, external path
+	// and component" separated by ":".  For RE sensors, component will be
+	// daemon-name and for PFE sensors it will be "PFE".
+	SensorName *string `protobuf:"bytes,4,opt,name=sensor_name,json=sensorName" json:"sensor_name,omitempty"`
+	// sequence number, monotonically increasing for each
+	// system_id, component_id, sub_component_id + sensor_name.
+	SequenceNumber *uint32 `protobuf:"varint,5,opt,name=sequence_number,json=sequenceNumber" json:"sequence_number,omitempty"`
+	// timestamp (milliseconds since 00:00:00 UTC 1/1/1970)
+	Timestamp *uint64 `protobuf:"varint,6,opt,name=timestamp" json:"timestamp,omitempty"`
+	// major version
+	VersionMajor *uint32 `protobuf:"varint,7,opt,name=version_major,json=versionMajor" json:"version_major,omitempty"`
+	// minor version
+	VersionMinor *uint32 `protobuf:"varint,8,opt,name=version_minor,json=versionMinor" json:"version_minor,omitempty"`
+	// end-of-message marker, set to true when the end of wrap is reached
+	Eom                  *bool              `protobuf:"varint,10,opt,name=eom" json:"eom,omitempty"`
+	Ietf                 *IETFSensors       `protobuf:"bytes,100,opt,name=ietf" json:"ietf,omitempty"`
+	Enterprise           *EnterpriseSensors `protobuf:"bytes,101,opt,name=enterprise" json:"enterprise,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TelemetryStream) Reset()         { *m = TelemetryStream{} }
+func (m *TelemetryStream) String() string { return proto.CompactTextString(m) }
+func (*TelemetryStream) ProtoMessage()    {}
+func (*TelemetryStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_telemetry_top_4ad52162a241f712, []int{0}
+}
+func (m *TelemetryStream) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TelemetryStream.Unmarshal(m, b)
+}
+func (m *TelemetryStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TelemetryStream.Marshal(b, m, deterministic)
+}
+func (dst *TelemetryStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TelemetryStream.Merge(dst, src)
+}
+func (m *TelemetryStream) XXX_Size() int {
+	return xxx_messageInfo_TelemetryStream.Size(m)
+}
+func (m *TelemetryStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_TelemetryStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TelemetryStream proto.InternalMessageInfo
+
+func (m *TelemetryStream) GetSystemId() string {
+	if m != nil && m.SystemId != nil {
+		return *m.SystemId
+	}
+	return ""
+}
+
+func (m *TelemetryStream) GetComponentId() uint32 {
+	if m != nil && m.ComponentId != nil {
+		return *m.ComponentId
+	}
+	return 0
+}
+
+func (m *TelemetryStream) GetSubComponentId() uint32 {
+	if m != nil && m.SubComponentId != nil {
+		return *m.SubComponentId
+	}
+	return 0
+}
+
+func (m *TelemetryStream) GetSensorName() string {
+	if m != nil && m.SensorName != nil {
+		return *m.SensorName
+	}
+	return ""
+}
+
+func (m *TelemetryStream) GetSequenceNumber() uint32 {
+	if m != nil && m.SequenceNumber != nil {
+		return *m.SequenceNumber
+	}
+	return 0
+}
+
+func (m *TelemetryStream) GetTimestamp() uint64 {
+	if m != nil && m.Timestamp != nil {
+		return *m.Timestamp
+	}
+	return 0
+}
+
+func (m *TelemetryStream) GetVersionMajor() uint32 {
+	if m != nil && m.VersionMajor != nil {
+		return *m.VersionMajor
+	}
+	return 0
+}
+
+func (m *TelemetryStream) GetVersionMinor() uint32 {
+	if m != nil && m.VersionMinor != nil {
+		return *m.VersionMinor
+	}
+	return 0
+}
+
+func (m *TelemetryStream) GetEom() bool {
+	if m != nil && m.Eom != nil {
+		return *m.Eom
+	}
+	return false
+}
+
+func (m *TelemetryStream) GetIetf() *IETFSensors {
+	if m != nil {
+		return m.Ietf
+	}
+	return nil
+}
+
+func (m *TelemetryStream) GetEnterprise() *EnterpriseSensors {
+	if m != nil {
+		return m.Enterprise
+	}
+	return nil
+}
+
+type IETFSensors struct {
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
+}
+
+func (m *IETFSensors) Reset()         { *m = IETFSensors{} }
+func (m *IETFSensors) String() string { return proto.CompactTextString(m) }
+func (*IETFSensors) ProtoMessage()    {}
+func (*IETFSensors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_telemetry_top_4ad52162a241f712, []int{1}
+}
+
+var extRange_IETFSensors = []proto.ExtensionRange{
+	{Start: 1, End: 536870911},
+}
+
+func (*IETFSensors) ExtensionRangeArray() []proto.ExtensionRange {
+	return extRange_IETFSensors
+}
+func (m *IETFSensors) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IETFSensors.Unmarshal(m, b)
+}
+func (m *IETFSensors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IETFSensors.Marshal(b, m, deterministic)
+}
+func (dst *IETFSensors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IETFSensors.Merge(dst, src)
+}
+func (m *IETFSensors) XXX_Size() int {
+	return xxx_messageInfo_IETFSensors.Size(m)
+}
+func (m *IETFSensors) XXX_DiscardUnknown() {
+	xxx_messageInfo_IETFSensors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IETFSensors proto.InternalMessageInfo
+
+type EnterpriseSensors struct {
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
+}
+
+func (m *EnterpriseSensors) Reset()         { *m = EnterpriseSensors{} }
+func (m *EnterpriseSensors) String() string { return proto.CompactTextString(m) }
+func (*EnterpriseSensors) ProtoMessage()    {}
+func (*EnterpriseSensors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_telemetry_top_4ad52162a241f712, []int{2}
+}
+
+var extRange_EnterpriseSensors = []proto.ExtensionRange{
+	{Start: 1, End: 536870911},
+}
+
+func (*EnterpriseSensors) ExtensionRangeArray() []proto.ExtensionRange {
+	return extRange_EnterpriseSensors
+}
+func (m *EnterpriseSensors) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnterpriseSensors.Unmarshal(m, b)
+}
+func (m *EnterpriseSensors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnterpriseSensors.Marshal(b, m, deterministic)
+}
+func (dst *EnterpriseSensors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnterpriseSensors.Merge(dst, src)
+}
+func (m *EnterpriseSensors) XXX_Size() int {
+	return xxx_messageInfo_EnterpriseSensors.Size(m)
+}
+func (m *EnterpriseSensors) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnterpriseSensors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnterpriseSensors proto.InternalMessageInfo
+
+type JuniperNetworksSensors struct {
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
+}
+
+func (m *JuniperNetworksSensors) Reset()         { *m = JuniperNetworksSensors{} }
+func (m *JuniperNetworksSensors) String() string { return proto.CompactTextString(m) }
+func (*JuniperNetworksSensors) ProtoMessage()    {}
+func (*JuniperNetworksSensors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_telemetry_top_4ad52162a241f712, []int{3}
+}
+
+var extRange_JuniperNetworksSensors = []proto.ExtensionRange{
+	{Start: 1, End: 536870911},
+}
+
+func (*JuniperNetworksSensors) ExtensionRangeArray() []proto.ExtensionRange {
+	return extRange_JuniperNetworksSensors
+}
+func (m *JuniperNetworksSensors) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JuniperNetworksSensors.Unmarshal(m, b)
+}
+func (m *JuniperNetworksSensors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JuniperNetworksSensors.Marshal(b, m, deterministic)
+}
+func (dst *JuniperNetworksSensors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JuniperNetworksSensors.Merge(dst, src)
+}
+func (m *JuniperNetworksSensors) XXX_Size() int {
+	return xxx_messageInfo_JuniperNetworksSensors.Size(m)
+}
+func (m *JuniperNetworksSensors) XXX_DiscardUnknown() {
+	xxx_messageInfo_JuniperNetworksSensors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JuniperNetworksSensors proto.InternalMessageInfo
+
+var E_JuniperNetworks = &proto.ExtensionDesc{
+	ExtendedType:  (*EnterpriseSensors)(nil),
+	ExtensionType: (*JuniperNetworksSensors)(nil),
+	Field:         2636,
+	Name:          "juniperNetworks",
+	Tag:           "bytes,2636,opt,name=juniperNetworks",
+	Filename:      "telemetry_top.proto",
+}
+
+func init() {
+	proto.RegisterType((*TelemetryStream)(nil), "TelemetryStream")
+	proto.RegisterType((*IETFSensors)(nil), "IETFSensors")
+	proto.RegisterType((*EnterpriseSensors)(nil), "EnterpriseSensors")
+	proto.RegisterType((*JuniperNetworksSensors)(nil), "JuniperNetworksSensors")
+	proto.RegisterExtension(E_JuniperNetworks)
+}
+
+func init() { proto.RegisterFile("telemetry_top.proto", fileDescriptor_telemetry_top_4ad52162a241f712) }
+
+var fileDescriptor_telemetry_top_4ad52162a241f712 = []byte{
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x8f, 0xcd, 0x6e, 0x9b, 0x40,
+	0x14, 0x46, 0x85, 0xb1, 0x5b, 0xb8, 0xd8, 0xb5, 0x3b, 0xad, 0xea, 0x91, 0xda, 0xaa, 0x94, 0x2e,
+	0x8a, 0xba, 0x60, 0xe1, 0x65, 0x97, 0xad, 0x5c, 0xc9, 0x95, 0xe2, 0x05, 0xb6, 0xb2, 0xc8, 0x06,
+	0x61, 0x73, 0x23, 0x8d, 0x93, 0x99, 0x21, 0x33, 0x43, 0x22, 0xef, 0x78, 0x89, 0xbc, 0x55, 0x1e,
+	0x2a, 0x02, 0xff, 0x04, 0x62, 0xef, 0xd0, 0xf9, 0x0e, 0x47, 0x77, 0xe0, 0x83, 0xc1, 0x5b, 0xe4,
+	0x68, 0xd4, 0x36, 0x31, 0x32, 0x8f, 0x72, 0x25, 0x8d, 0x0c, 0x1e, 0x6d, 0x18, 0x2e, 0x0f, 0x7c,
+	0x61, 0x14, 0xa6, 0x9c, 0x7c, 0x06, 0x57, 0x6f, 0xb5, 0x41, 0x9e, 0xb0, 0x8c, 0x5a, 0x7e, 0x27,
+	0x74, 0x63, 0x67, 0x07, 0x66, 0x19, 0xf9, 0x0e, 0xfd, 0xb5, 0xe4, 0xb9, 0x14, 0x28, 0x4c, 0xb5,
+	0x77, 0x7c, 0x2b, 0x1c, 0xc4, 0xde, 0x91, 0xcd, 0x32, 0x12, 0xc2, 0x48, 0x17, 0xab, 0xa4, 0xa5,
+	0xd9, 0xb5, 0xf6, 0x4e, 0x17, 0xab, 0xbf, 0x0d, 0xf3, 0x1b, 0x78, 0x1a, 0x85, 0x96, 0x2a, 0x11,
+	0x29, 0x47, 0xda, 0xf5, 0xad, 0xd0, 0x8d, 0x61, 0x87, 0xe6, 0x29, 0x47, 0xf2, 0x13, 0x86, 0x1a,
+	0xef, 0x0a, 0x14, 0x6b, 0x4c, 0x44, 0xc1, 0x57, 0xa8, 0x68, 0x6f, 0x5f, 0xda, 0xe3, 0x79, 0x4d,
+	0xc9, 0x17, 0x70, 0x0d, 0xe3, 0xa8, 0x4d, 0xca, 0x73, 0xfa, 0xc6, 0xb7, 0xc2, 0x6e, 0xfc, 0x02,
+	0xc8, 0x0f, 0x18, 0xdc, 0xa3, 0xd2, 0x4c, 0x8a, 0x84, 0xa7, 0x1b, 0xa9, 0xe8, 0xdb, 0x3a, 0xd2,
+	0xdf, 0xc3, 0x8b, 0x8a, 0xb5, 0x24, 0x26, 0xa4, 0xa2, 0x4e, 0x5b, 0xaa, 0x18, 0x19, 0x81, 0x8d,
+	0x92, 0x53, 0xf0, 0xad, 0xd0, 0x89, 0xab, 0x4f, 0xe2, 0x43, 0x97, 0xa1, 0xb9, 0xa6, 0x99, 0x6f,
+	0x85, 0xde, 0xa4, 0x1f, 0xcd, 0xa6, 0xcb, 0x7f, 0x8b, 0xfa, 0x05, 0x3a, 0xae, 0x17, 0x32, 0x01,
+	0x40, 0x61, 0x50, 0xe5, 0x8a, 0x69, 0xa4, 0x58, 0x7b, 0x24, 0x9a, 0x1e, 0xd1, 0xc1, 0x6e, 0x58,
+	0xc1, 0x18, 0xbc, 0x46, 0xe8, 0x97, 0xe3, 0x58, 0xa3, 0xb2, 0x2c, 0xcb, 0x4e, 0xf0, 0x15, 0xde,
+	0x9f, 0xfc, 0xd9, 0x98, 0x03, 0xf8, 0xf4, 0xbf, 0x10, 0x2c, 0x47, 0x35, 0x47, 0xf3, 0x20, 0xd5,
+	0x8d, 0x3e, 0x71, 0x7e, 0x5f, 0xc2, 0x70, 0xd3, 0x76, 0xc8, 0x99, 0x73, 0xe8, 0xd3, 0xc7, 0xfa,
+	0xd2, 0x71, 0x74, 0x3e, 0x18, 0xbf, 0x8e, 0xfc, 0xe9, 0x5d, 0xd9, 0x1b, 0xc3, 0x9e, 0x07, 0x00,
+	0xf9, 0x68, 0x83, 0x93, 0x68, 0x02, 0x00, 0x00,
+}
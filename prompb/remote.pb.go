@@ -0,0 +1,223 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: remote.proto
+
+package prompb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type WriteRequest struct {
+	Timeseries           []*TimeSeries `protobuf:"bytes,1,rep,name=timeseries,proto3" json:"timeseries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *WriteRequest) Reset()         { *m = WriteRequest{} }
+func (m *WriteRequest) String() string { return proto.CompactTextString(m) }
+func (*WriteRequest) ProtoMessage()    {}
+func (*WriteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_remote_6beaabd590c2a714, []int{0}
+}
+func (m *WriteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WriteRequest.Unmarshal(m, b)
+}
+func (m *WriteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WriteRequest.Marshal(b, m, deterministic)
+}
+func (dst *WriteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteRequest.Merge(dst, src)
+}
+func (m *WriteRequest) XXX_Size() int {
+	return xxx_messageInfo_WriteRequest.Size(m)
+}
+func (m *WriteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteRequest proto.InternalMessageInfo
+
+func (m *WriteRequest) GetTimeseries() []*TimeSeries {
+	if m != nil {
+		return m.Timeseries
+	}
+	return nil
+}
+
+type Sample struct {
+	Value                float64  `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Timestamp            int64    `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Sample) Reset()         { *m = Sample{} }
+func (m *Sample) String() string { return proto.CompactTextString(m) }
+func (*Sample) ProtoMessage()    {}
+func (*Sample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_remote_6beaabd590c2a714, []int{1}
+}
+func (m *Sample) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Sample.Unmarshal(m, b)
+}
+func (m *Sample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Sample.Marshal(b, m, deterministic)
+}
+func (dst *Sample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sample.Merge(dst, src)
+}
+func (m *Sample) XXX_Size() int {
+	return xxx_messageInfo_Sample.Size(m)
+}
+func (m *Sample) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sample proto.InternalMessageInfo
+
+func (m *Sample) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *Sample) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// TimeSeries represents samples and labels for a single time series.
+type TimeSeries struct {
+	Labels               []*Label  `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
+	Samples              []*Sample `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *TimeSeries) Reset()         { *m = TimeSeries{} }
+func (m *TimeSeries) String() string { return proto.CompactTextString(m) }
+func (*TimeSeries) ProtoMessage()    {}
+func (*TimeSeries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_remote_6beaabd590c2a714, []int{2}
+}
+func (m *TimeSeries) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeSeries.Unmarshal(m, b)
+}
+func (m *TimeSeries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeSeries.Marshal(b, m, deterministic)
+}
+func (dst *TimeSeries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeSeries.Merge(dst, src)
+}
+func (m *TimeSeries) XXX_Size() int {
+	return xxx_messageInfo_TimeSeries.Size(m)
+}
+func (m *TimeSeries) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeSeries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeSeries proto.InternalMessageInfo
+
+func (m *TimeSeries) GetLabels() []*Label {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *TimeSeries) GetSamples() []*Sample {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
+type Label struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Label) Reset()         { *m = Label{} }
+func (m *Label) String() string { return proto.CompactTextString(m) }
+func (*Label) ProtoMessage()    {}
+func (*Label) Descriptor() ([]byte, []int) {
+	return fileDescriptor_remote_6beaabd590c2a714, []int{3}
+}
+func (m *Label) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Label.Unmarshal(m, b)
+}
+func (m *Label) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Label.Marshal(b, m, deterministic)
+}
+func (dst *Label) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Label.Merge(dst, src)
+}
+func (m *Label) XXX_Size() int {
+	return xxx_messageInfo_Label.Size(m)
+}
+func (m *Label) XXX_DiscardUnknown() {
+	xxx_messageInfo_Label.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Label proto.InternalMessageInfo
+
+func (m *Label) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Label) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*WriteRequest)(nil), "prometheus.WriteRequest")
+	proto.RegisterType((*Sample)(nil), "prometheus.Sample")
+	proto.RegisterType((*TimeSeries)(nil), "prometheus.TimeSeries")
+	proto.RegisterType((*Label)(nil), "prometheus.Label")
+}
+
+func init() { proto.RegisterFile("remote.proto", fileDescriptor_remote_6beaabd590c2a714) }
+
+var fileDescriptor_remote_6beaabd590c2a714 = []byte{
+	// 221 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x3d, 0x4b, 0x04, 0x31,
+	0x10, 0x86, 0xc9, 0x9e, 0xb7, 0x7a, 0xe3, 0x35, 0x0e, 0x22, 0x5b, 0x58, 0x2c, 0xa9, 0x56, 0x90,
+	0x05, 0x15, 0xac, 0xac, 0x2c, 0xac, 0xac, 0x72, 0x82, 0x60, 0x97, 0x85, 0x01, 0x03, 0x89, 0x89,
+	0x49, 0xd6, 0xdf, 0x2f, 0x37, 0xb9, 0x25, 0xdb, 0x65, 0x66, 0x9e, 0xf7, 0x83, 0xc0, 0x3e, 0x92,
+	0xf3, 0x99, 0xc6, 0x10, 0x7d, 0xf6, 0x08, 0x21, 0x7a, 0x47, 0xf9, 0x9b, 0xe6, 0x24, 0xdf, 0x60,
+	0xff, 0x19, 0x4d, 0x26, 0x45, 0xbf, 0x33, 0xa5, 0x8c, 0xcf, 0x00, 0xd9, 0x38, 0x4a, 0x14, 0x0d,
+	0xa5, 0x4e, 0xf4, 0x9b, 0xe1, 0xf2, 0xf1, 0x66, 0xac, 0x82, 0xf1, 0xc3, 0x38, 0x3a, 0xf0, 0x55,
+	0xad, 0x48, 0xf9, 0x02, 0xed, 0x41, 0xbb, 0x60, 0x09, 0xaf, 0x61, 0xfb, 0xa7, 0xed, 0x4c, 0x9d,
+	0xe8, 0xc5, 0x20, 0x54, 0x19, 0xf0, 0x16, 0x76, 0x4c, 0x67, 0xed, 0x42, 0xd7, 0xf4, 0x62, 0xd8,
+	0xa8, 0xba, 0x90, 0x04, 0x50, 0x7d, 0xf1, 0x0e, 0x5a, 0xab, 0x27, 0xb2, 0x4b, 0xfe, 0xd5, 0x3a,
+	0xff, 0xfd, 0x78, 0x51, 0x27, 0x00, 0xef, 0xe1, 0x3c, 0x71, 0x6c, 0xea, 0x1a, 0x66, 0x71, 0xcd,
+	0x96, 0x46, 0x6a, 0x41, 0xe4, 0x03, 0x6c, 0x59, 0x8e, 0x08, 0x67, 0x3f, 0xda, 0x95, 0x8a, 0x3b,
+	0xc5, 0xef, 0xda, 0xbb, 0xe1, 0x65, 0x19, 0x5e, 0x2f, 0xbe, 0xda, 0xa3, 0x61, 0x98, 0xa6, 0x96,
+	0x3f, 0xef, 0xe9, 0x7f, 0x00, 0x6a, 0x66, 0x97, 0x1a, 0x4c, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,338 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: record.proto
+
+package record
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Record struct {
+	Measurement          string            `protobuf:"bytes,1,opt,name=measurement,proto3" json:"measurement,omitempty"`
+	Tags                 map[string]string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Fields               map[string]*Value `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Timestamp            int64             `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Record) Reset()         { *m = Record{} }
+func (m *Record) String() string { return proto.CompactTextString(m) }
+func (*Record) ProtoMessage()    {}
+func (*Record) Descriptor() ([]byte, []int) {
+	return fileDescriptor_record_f57121263af0b12a, []int{0}
+}
+func (m *Record) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Record.Unmarshal(m, b)
+}
+func (m *Record) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Record.Marshal(b, m, deterministic)
+}
+func (dst *Record) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Record.Merge(dst, src)
+}
+func (m *Record) XXX_Size() int {
+	return xxx_messageInfo_Record.Size(m)
+}
+func (m *Record) XXX_DiscardUnknown() {
+	xxx_messageInfo_Record.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Record proto.InternalMessageInfo
+
+func (m *Record) GetMeasurement() string {
+	if m != nil {
+		return m.Measurement
+	}
+	return ""
+}
+
+func (m *Record) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Record) GetFields() map[string]*Value {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *Record) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type Value struct {
+	// Types that are valid to be assigned to Value:
+	//	*Value_IntVal
+	//	*Value_UintVal
+	//	*Value_DoubleVal
+	//	*Value_BoolVal
+	//	*Value_StringVal
+	Value                isValue_Value `protobuf_oneof:"value"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Value) Reset()         { *m = Value{} }
+func (m *Value) String() string { return proto.CompactTextString(m) }
+func (*Value) ProtoMessage()    {}
+func (*Value) Descriptor() ([]byte, []int) {
+	return fileDescriptor_record_f57121263af0b12a, []int{1}
+}
+func (m *Value) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Value.Unmarshal(m, b)
+}
+func (m *Value) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Value.Marshal(b, m, deterministic)
+}
+func (dst *Value) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Value.Merge(dst, src)
+}
+func (m *Value) XXX_Size() int {
+	return xxx_messageInfo_Value.Size(m)
+}
+func (m *Value) XXX_DiscardUnknown() {
+	xxx_messageInfo_Value.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Value proto.InternalMessageInfo
+
+type isValue_Value interface {
+	isValue_Value()
+}
+
+type Value_IntVal struct {
+	IntVal int64 `protobuf:"varint,1,opt,name=int_val,json=intVal,proto3,oneof"`
+}
+
+type Value_UintVal struct {
+	UintVal uint64 `protobuf:"varint,2,opt,name=uint_val,json=uintVal,proto3,oneof"`
+}
+
+type Value_DoubleVal struct {
+	DoubleVal float64 `protobuf:"fixed64,3,opt,name=double_val,json=doubleVal,proto3,oneof"`
+}
+
+type Value_BoolVal struct {
+	BoolVal bool `protobuf:"varint,4,opt,name=bool_val,json=boolVal,proto3,oneof"`
+}
+
+type Value_StringVal struct {
+	StringVal string `protobuf:"bytes,5,opt,name=string_val,json=stringVal,proto3,oneof"`
+}
+
+func (*Value_IntVal) isValue_Value() {}
+
+func (*Value_UintVal) isValue_Value() {}
+
+func (*Value_DoubleVal) isValue_Value() {}
+
+func (*Value_BoolVal) isValue_Value() {}
+
+func (*Value_StringVal) isValue_Value() {}
+
+func (m *Value) GetValue() isValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Value) GetIntVal() int64 {
+	if x, ok := m.GetValue().(*Value_IntVal); ok {
+		return x.IntVal
+	}
+	return 0
+}
+
+func (m *Value) GetUintVal() uint64 {
+	if x, ok := m.GetValue().(*Value_UintVal); ok {
+		return x.UintVal
+	}
+	return 0
+}
+
+func (m *Value) GetDoubleVal() float64 {
+	if x, ok := m.GetValue().(*Value_DoubleVal); ok {
+		return x.DoubleVal
+	}
+	return 0
+}
+
+func (m *Value) GetBoolVal() bool {
+	if x, ok := m.GetValue().(*Value_BoolVal); ok {
+		return x.BoolVal
+	}
+	return false
+}
+
+func (m *Value) GetStringVal() string {
+	if x, ok := m.GetValue().(*Value_StringVal); ok {
+		return x.StringVal
+	}
+	return ""
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Value) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Value_OneofMarshaler, _Value_OneofUnmarshaler, _Value_OneofSizer, []interface{}{
+		(*Value_IntVal)(nil),
+		(*Value_UintVal)(nil),
+		(*Value_DoubleVal)(nil),
+		(*Value_BoolVal)(nil),
+		(*Value_StringVal)(nil),
+	}
+}
+
+func _Value_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Value)
+	// value
+	switch x := m.Value.(type) {
+	case *Value_IntVal:
+		b.EncodeVarint(1<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.IntVal))
+	case *Value_UintVal:
+		b.EncodeVarint(2<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.UintVal))
+	case *Value_DoubleVal:
+		b.EncodeVarint(3<<3 | proto.WireFixed64)
+		b.EncodeFixed64(math.Float64bits(x.DoubleVal))
+	case *Value_BoolVal:
+		t := uint64(0)
+		if x.BoolVal {
+			t = 1
+		}
+		b.EncodeVarint(4<<3 | proto.WireVarint)
+		b.EncodeVarint(t)
+	case *Value_StringVal:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.StringVal)
+	case nil:
+	default:
+		return fmt.Errorf("Value.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Value_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Value)
+	switch tag {
+	case 1: // value.int_val
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Value = &Value_IntVal{int64(x)}
+		return true, err
+	case 2: // value.uint_val
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Value = &Value_UintVal{x}
+		return true, err
+	case 3: // value.double_val
+		if wire != proto.WireFixed64 {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeFixed64()
+		m.Value = &Value_DoubleVal{math.Float64frombits(x)}
+		return true, err
+	case 4: // value.bool_val
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Value = &Value_BoolVal{x != 0}
+		return true, err
+	case 5: // value.string_val
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Value = &Value_StringVal{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Value_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Value)
+	// value
+	switch x := m.Value.(type) {
+	case *Value_IntVal:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.IntVal))
+	case *Value_UintVal:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.UintVal))
+	case *Value_DoubleVal:
+		n += 1 // tag and wire
+		n += 8
+	case *Value_BoolVal:
+		n += 1 // tag and wire
+		n += 1
+	case *Value_StringVal:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.StringVal)))
+		n += len(x.StringVal)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterType((*Record)(nil), "record.Record")
+	proto.RegisterMapType((map[string]*Value)(nil), "record.Record.FieldsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "record.Record.TagsEntry")
+	proto.RegisterType((*Value)(nil), "record.Value")
+}
+
+func init() { proto.RegisterFile("record.proto", fileDescriptor_record_f57121263af0b12a) }
+
+var fileDescriptor_record_f57121263af0b12a = []byte{
+	// 313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcd, 0x4a, 0x33, 0x31,
+	0x14, 0x86, 0x9b, 0x49, 0x3b, 0xed, 0x9c, 0xf9, 0x3e, 0x90, 0xe0, 0x22, 0x56, 0xc1, 0xa1, 0x6e,
+	0x66, 0x21, 0x5d, 0xd4, 0x85, 0xe2, 0xb2, 0xa0, 0xcc, 0x3a, 0x48, 0x17, 0x6e, 0x24, 0xb5, 0xb1,
+	0x0c, 0x66, 0x26, 0x25, 0xc9, 0x14, 0x7a, 0x45, 0xae, 0xbc, 0x47, 0xc9, 0x4f, 0x75, 0x10, 0x77,
+	0xc9, 0xf3, 0x9e, 0xe7, 0xe4, 0xe7, 0xc0, 0x3f, 0x2d, 0x5e, 0x95, 0xde, 0xcc, 0x77, 0x5a, 0x59,
+	0x45, 0xd2, 0xb0, 0x9b, 0x7d, 0x26, 0x90, 0x32, 0xbf, 0x24, 0x05, 0xe4, 0x8d, 0xe0, 0xa6, 0xd3,
+	0xa2, 0x11, 0xad, 0xa5, 0xa8, 0x40, 0x65, 0xc6, 0xfa, 0x88, 0x5c, 0xc3, 0xd0, 0xf2, 0xad, 0xa1,
+	0x49, 0x81, 0xcb, 0x7c, 0x41, 0xe7, 0xb1, 0x63, 0xf0, 0xe7, 0x4f, 0x7c, 0x6b, 0x1e, 0x5a, 0xab,
+	0x0f, 0xcc, 0x57, 0x91, 0x05, 0xa4, 0x6f, 0xb5, 0x90, 0x1b, 0x43, 0xb1, 0xaf, 0x9f, 0xfe, 0xaa,
+	0x7f, 0xf4, 0x61, 0x30, 0x62, 0x25, 0xb9, 0x80, 0xcc, 0xd6, 0x8d, 0x30, 0x96, 0x37, 0x3b, 0x3a,
+	0x2c, 0x50, 0x89, 0xd9, 0x0f, 0x98, 0xde, 0x42, 0xf6, 0x7d, 0x08, 0x39, 0x01, 0xfc, 0x2e, 0x0e,
+	0xf1, 0x9a, 0x6e, 0x49, 0x4e, 0x61, 0xb4, 0xe7, 0xb2, 0x13, 0x34, 0xf1, 0x2c, 0x6c, 0xee, 0x93,
+	0x3b, 0x34, 0xad, 0x20, 0xef, 0x9d, 0xf6, 0x87, 0x7a, 0xd5, 0x57, 0xf3, 0xc5, 0xff, 0xe3, 0x55,
+	0x57, 0x0e, 0xf6, 0x3a, 0xcd, 0x3e, 0x10, 0x8c, 0x3c, 0x24, 0x67, 0x30, 0xae, 0x5b, 0xfb, 0xb2,
+	0xe7, 0xd2, 0x37, 0xc2, 0xd5, 0x80, 0xa5, 0x75, 0x6b, 0x57, 0x5c, 0x92, 0x73, 0x98, 0x74, 0xc7,
+	0xcc, 0x35, 0x1c, 0x56, 0x03, 0x36, 0xee, 0x62, 0x78, 0x09, 0xb0, 0x51, 0xdd, 0x5a, 0x0a, 0x1f,
+	0xe3, 0x02, 0x95, 0xa8, 0x1a, 0xb0, 0x2c, 0xb0, 0x68, 0xaf, 0x95, 0x92, 0x3e, 0x76, 0x5f, 0x30,
+	0x71, 0xb6, 0x23, 0xd1, 0x36, 0x56, 0xd7, 0xed, 0xd6, 0xc7, 0x23, 0xf7, 0x02, 0x67, 0x07, 0xb6,
+	0xe2, 0x72, 0x39, 0x8e, 0x2f, 0x59, 0x4e, 0x9e, 0xe3, 0x8c, 0xd7, 0xa9, 0x1f, 0xf9, 0xcd, 0xd7,
+	0x00, 0x7a, 0x58, 0xef, 0xf9, 0x02, 0x02, 0x00, 0x00,
+}
@@ -1,8 +1,13 @@
 package rest
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
 	"sync"
+	"time"
 
 	bolt "github.com/coreos/bbolt"
 	"github.com/gin-contrib/cors"
@@ -15,27 +20,94 @@ const zeroUUID = "00000000-0000-0000-0000-000000000000"
 
 //GRPCCfg aaaa
 type GRPCCfg struct {
-	Host        string    `json:"host"`
-	Port        int       `json:"port"`
-	User        string    `json:"user"`
-	Password    string    `json:"password"`
-	Meta        bool      `json:"meta"`
-	EOS         bool      `json:"eos"`
-	CID         string    `json:"cid"`
-	WS          int32     `json:"ws"`
-	TLS         TLSCfg    `json:"tls"`
-	Paths       []Spath   `json:"paths"`
-	Compression string    `json:"compression"`
-	UUID        uuid.UUID `json:"uuid"`
-	Removed     bool      `json:"removed"`
+	Host        string      `json:"host"`
+	Port        int         `json:"port"`
+	User        string      `json:"user"`
+	Password    string      `json:"password"`
+	Meta        bool        `json:"meta"`
+	EOS         bool        `json:"eos"`
+	CID         string      `json:"cid"`
+	WS          int32       `json:"ws"`
+	TLS         TLSCfg      `json:"tls"`
+	Paths       []Spath     `json:"paths"`
+	Compression string      `json:"compression"`
+	Protocol    string      `json:"protocol"`
+	GNMI        GNMICfg     `json:"gnmi"`
+	DialOut     bool        `json:"dialout"`
+	Collectors  []Collector `json:"collectors"`
+	// Group picks forwarding classes shared by devices with the same CoS config,
+	// ForwardingClasses of the device itself map queue numbers to class names
+	Group             string            `json:"group"`
+	ForwardingClasses map[string]string `json:"forwarding_classes"`
+	UUID              uuid.UUID         `json:"uuid"`
+	Removed           bool              `json:"removed"`
 	sync.RWMutex
 }
 
-//Spath aaa
+//Subscription modes of a path, empty Mode keeps the old behaviour
+//where a zero Freq means on change
+const (
+	ModePeriodic = "periodic"
+	ModeOnChange = "on_change"
+)
+
+//Spath a sensor path with its subscription options.
+//Freq and MaxSilentInterval are in ms
 type Spath struct {
-	Path string `json:"path"`
-	Freq uint64 `json:"freq"`
-	Mode string `json:"mode"`
+	Path              string `json:"path"`
+	Freq              uint64 `json:"freq"`
+	Mode              string `json:"mode"`
+	Filter            string `json:"filter"`
+	SuppressUnchanged bool   `json:"suppress_unchanged"`
+	MaxSilentInterval uint64 `json:"max_silent_interval"`
+}
+
+func (p *Spath) validate() error {
+	switch p.Mode {
+	case "", ModeOnChange:
+	case ModePeriodic:
+		if p.Freq == 0 {
+			return fmt.Errorf("path %s: periodic mode needs freq", p.Path)
+		}
+	default:
+		return fmt.Errorf("path %s: unknown mode %q, use %q or %q", p.Path, p.Mode, ModePeriodic, ModeOnChange)
+	}
+	if p.MaxSilentInterval != 0 && !p.SuppressUnchanged {
+		return fmt.Errorf("path %s: max_silent_interval only makes sense with suppress_unchanged", p.Path)
+	}
+	return nil
+}
+
+func (g *GRPCCfg) validate() error {
+	for i := range g.Paths {
+		err := g.Paths[i].validate()
+		if err != nil {
+			return err
+		}
+	}
+	for queue := range g.ForwardingClasses {
+		if _, err := strconv.Atoi(queue); err != nil {
+			return fmt.Errorf("forwarding classes: queue %q is not a number", queue)
+		}
+	}
+	return nil
+}
+
+//GNMICfg holds gNMI specific subscription settings
+//used when Protocol is set to "gnmi"
+type GNMICfg struct {
+	Mode         string `json:"mode"`
+	Encoding     string `json:"encoding"`
+	PollInterval uint64 `json:"poll_interval"`
+	Origin       string `json:"origin"`
+	Target       string `json:"target"`
+}
+
+//Collector is an endpoint a device should send data to
+//instead of the connection the subscription was made on
+type Collector struct {
+	Address string `json:"address"`
+	Port    uint32 `json:"port"`
 }
 
 //TLSCfg aaa
@@ -47,6 +119,59 @@ type TLSCfg struct {
 	ServerName string `json:"server_name"`
 }
 
+//SensorLatency export latency of a sensor in ms, over recent messages
+type SensorLatency struct {
+	Sensor string  `json:"sensor"`
+	Count  uint64  `json:"count"`
+	P50    float64 `json:"p50"`
+	P95    float64 `json:"p95"`
+	Max    float64 `json:"max"`
+	Skew   bool    `json:"skew"`
+}
+
+//DeviceStatus is what the collector knows about a device connection
+type DeviceStatus struct {
+	UUID             uuid.UUID `json:"uuid"`
+	Host             string    `json:"host"`
+	State            string    `json:"state"`
+	LoginResult      string    `json:"login_result"`
+	SubscribedAt     time.Time `json:"subscribed_at"`
+	LastMessage      time.Time `json:"last_message"`
+	Messages         uint64    `json:"messages"`
+	KVs              uint64    `json:"kvs"`
+	PayloadBytes     uint64    `json:"payload_bytes"`
+	PayloadWireBytes uint64    `json:"payload_wire_bytes"`
+	HeaderWireBytes  uint64    `json:"header_wire_bytes"`
+	DecodeErrors     uint64    `json:"decode_errors"`
+	Reconnects       uint64    `json:"reconnects"`
+	Gaps             uint64    `json:"gaps"`
+	Reorders         uint64    `json:"reorders"`
+	Dups             uint64    `json:"dups"`
+	Drops            uint64    `json:"drops"`
+}
+
+//StatusProvider gives access to runtime state of devices
+//which only the collector knows about
+type StatusProvider interface {
+	Latency(id uuid.UUID) ([]SensorLatency, bool)
+	Status(id uuid.UUID) (DeviceStatus, bool)
+	Statuses() []DeviceStatus
+}
+
+//Device config changes the collector is told about
+const (
+	CfgAdd = "add"
+	CfgUpd = "update"
+	CfgDel = "delete"
+)
+
+//CfgEvent is a device config change made through the API,
+//for deletes only the UUID of Cfg is set
+type CfgEvent struct {
+	Op  string
+	Cfg *GRPCCfg
+}
+
 //HTTPCfg holds http configuration gets passtes into this pkg
 type HTTPCfg struct {
 	Port   string
@@ -55,17 +180,27 @@ type HTTPCfg struct {
 }
 
 type handler struct {
-	db    *bolt.DB
-	cfgs  *[]*GRPCCfg
-	cfgCh chan *GRPCCfg
+	db     *bolt.DB
+	cfgCh  chan CfgEvent
+	status StatusProvider
+	// closed on stop, changes are refused from then on
+	done chan struct{}
 }
 
-//StartHTTPSrv strts http server
-func StartHTTPSrv(hcfg *HTTPCfg, db *bolt.DB, cfgs *[]*GRPCCfg, cfgCh chan *GRPCCfg) error {
+//HTTPSrv is the API, changes of devices made through it are sent on cfgCh
+type HTTPSrv struct {
+	srv  *http.Server
+	done chan struct{}
+	once sync.Once
+}
+
+//NewHTTPSrv sets up the API, Start serves it
+func NewHTTPSrv(hcfg *HTTPCfg, db *bolt.DB, cfgCh chan CfgEvent, status StatusProvider) *HTTPSrv {
 	h := handler{
-		db:    db,
-		cfgs:  cfgs,
-		cfgCh: cfgCh,
+		db:     db,
+		cfgCh:  cfgCh,
+		status: status,
+		done:   make(chan struct{}),
 	}
 	gin.SetMode(gin.ReleaseMode)
 	router := gin.Default()
@@ -85,19 +220,65 @@ func StartHTTPSrv(hcfg *HTTPCfg, db *bolt.DB, cfgs *[]*GRPCCfg, cfgCh chan *GRPC
 		api.POST("/device", h.addDevice)
 		api.PUT("/device", h.updDevice)
 		api.DELETE("/device/:id", h.delDevice)
+		api.GET("/device/:id/latency", h.getLatency)
+		api.GET("/device/:id/status", h.getStatus)
+		api.GET("/devices/status", h.getStatuses)
+	}
+	return &HTTPSrv{
+		srv: &http.Server{
+			Addr:    hcfg.Addr + ":" + hcfg.Port,
+			Handler: router,
+		},
+		done: h.done,
 	}
+}
+
+//Start blocks serving the API, it returns nil once Stop was called
+func (s *HTTPSrv) Start() error {
 	logrus.WithFields(logrus.Fields{
-		"Port": hcfg.Port,
-		"Addr": hcfg.Addr,
+		"Addr": s.srv.Addr,
 	}).Info("http server starting ...")
-	err := router.Run(hcfg.Addr + ":" + hcfg.Port)
-	if err != nil {
-		return err
+	err := s.srv.ListenAndServe()
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+//Stop refuses further changes and waits for requests in flight until ctx is done,
+//nothing is sent on cfgCh once it returned
+func (s *HTTPSrv) Stop(ctx context.Context) error {
+	s.once.Do(func() { close(s.done) })
+	return s.srv.Shutdown(ctx)
+}
+
+// stopping refuses changes once we are shutting down
+func (h *handler) stopping(c *gin.Context) bool {
+	select {
+	case <-h.done:
+		c.AbortWithStatusJSON(503, "shutting down")
+		return true
+	default:
+		return false
+	}
+}
+
+// apply hands a stored change to the collector, if we started stopping
+// in the meantime it is stored only and applied on the next start
+func (h *handler) apply(c *gin.Context, ev CfgEvent) bool {
+	select {
+	case h.cfgCh <- ev:
+		return true
+	case <-h.done:
+		c.AbortWithStatusJSON(503, "shutting down, the change is applied on the next start")
+		return false
 	}
-	return nil
 }
 
 func (h *handler) delDevice(c *gin.Context) {
+	if h.stopping(c) {
+		return
+	}
 	ud, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		c.AbortWithStatusJSON(500, err.Error())
@@ -117,16 +298,44 @@ func (h *handler) delDevice(c *gin.Context) {
 		c.AbortWithStatusJSON(500, err.Error())
 		return
 	}
-	for _, cfg := range *h.cfgs {
-		cfg.Lock()
-		if cfg.UUID == ud {
-			cfg.Removed = true
-		}
-		cfg.Unlock()
+	if !h.apply(c, CfgEvent{Op: CfgDel, Cfg: &GRPCCfg{UUID: ud}}) {
+		return
 	}
 	c.JSON(200, c.Param("id"))
 }
 
+func (h *handler) getLatency(c *gin.Context) {
+	ud, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(400, err.Error())
+		return
+	}
+	l, ok := h.status.Latency(ud)
+	if !ok {
+		c.AbortWithStatusJSON(404, "device not found")
+		return
+	}
+	c.JSON(200, l)
+}
+
+func (h *handler) getStatus(c *gin.Context) {
+	ud, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(400, err.Error())
+		return
+	}
+	st, ok := h.status.Status(ud)
+	if !ok {
+		c.AbortWithStatusJSON(404, "device not found")
+		return
+	}
+	c.JSON(200, st)
+}
+
+func (h *handler) getStatuses(c *gin.Context) {
+	c.JSON(200, h.status.Statuses())
+}
+
 func (h *handler) getDevices(c *gin.Context) {
 	gCfgs := make([]*GRPCCfg, 0)
 	err := h.db.View(func(tx *bolt.Tx) error {
@@ -155,12 +364,20 @@ func (h *handler) getDevices(c *gin.Context) {
 }
 
 func (h *handler) updDevice(c *gin.Context) {
+	if h.stopping(c) {
+		return
+	}
 	var d GRPCCfg
 	err := c.BindJSON(&d)
 	if err != nil {
 		c.AbortWithStatusJSON(500, err)
 		return
 	}
+	err = d.validate()
+	if err != nil {
+		c.AbortWithStatusJSON(400, err.Error())
+		return
+	}
 	err = h.db.Update(func(tx *bolt.Tx) error {
 		b, err := tx.CreateBucketIfNotExists([]byte("devices"))
 		if err != nil {
@@ -176,16 +393,27 @@ func (h *handler) updDevice(c *gin.Context) {
 		c.AbortWithStatusJSON(500, err)
 		return
 	}
+	if !h.apply(c, CfgEvent{Op: CfgUpd, Cfg: &d}) {
+		return
+	}
 	c.JSON(200, &d)
 }
 
 func (h *handler) addDevice(c *gin.Context) {
+	if h.stopping(c) {
+		return
+	}
 	var d GRPCCfg
 	err := c.BindJSON(&d)
 	if err != nil {
 		c.AbortWithStatusJSON(500, err)
 		return
 	}
+	err = d.validate()
+	if err != nil {
+		c.AbortWithStatusJSON(400, err.Error())
+		return
+	}
 	d.UUID = uuid.NewV4()
 	err = h.db.Update(func(tx *bolt.Tx) error {
 		b, err := tx.CreateBucketIfNotExists([]byte("devices"))
@@ -202,6 +430,8 @@ func (h *handler) addDevice(c *gin.Context) {
 		c.AbortWithStatusJSON(500, err)
 		return
 	}
-	h.cfgCh <- &d
+	if !h.apply(c, CfgEvent{Op: CfgAdd, Cfg: &d}) {
+		return
+	}
 	c.JSON(200, &d)
 }